@@ -0,0 +1,181 @@
+// Package app wires menu-service's dependencies together so both the
+// production entrypoint (cmd/server) and integration tests can start and
+// stop a fully configured instance in-process.
+package app
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	authn "github.com/douglasswm/cafe-authn"
+	metrics "github.com/douglasswm/cafe-metrics"
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+	menuadminpb "github.com/douglasswm/student-cafe-protos/menuadmin"
+	"google.golang.org/grpc"
+
+	"menu-service/config"
+	"menu-service/internal/adminapi"
+	"menu-service/internal/grpcapi"
+	"menu-service/internal/httpapi"
+	"menu-service/internal/images"
+	"menu-service/internal/jobrunners"
+	"menu-service/internal/store"
+	"menu-service/pkg/jobs"
+	"menu-service/pkg/uploads"
+)
+
+// jobTickInterval is how often the jobs.Scheduler re-evaluates whether a
+// scheduled job is due. A minute matches the granularity of standard
+// cron expressions.
+const jobTickInterval = time.Minute
+
+// App is a fully wired menu-service instance.
+type App struct {
+	cfg       config.Config
+	Store     *store.Store
+	Metrics   *metrics.Registry
+	Scheduler *jobs.Scheduler
+	Verifier  *authn.Verifier
+	Uploads   *uploads.Handler
+
+	grpcServer *grpc.Server
+	grpcLis    net.Listener
+	httpServer *http.Server
+	adminSrv   *http.Server
+	stopJobs   context.CancelFunc
+	stopKeys   func()
+}
+
+// New builds an App from cfg: it opens the store, registers metrics, and
+// loads the scheduled-jobs config, but binds no listeners yet.
+func New(cfg config.Config) (*App, error) {
+	reg := metrics.NewRegistry("menu-service")
+
+	st, err := store.Open(cfg.DBDSN, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := jobs.LoadSpecs(cfg.JobsFile)
+	if err != nil {
+		return nil, err
+	}
+	runners := map[string]jobs.Runner{
+		"stock-replenishment": jobrunners.StockReplenishment{Store: st},
+		"specials-lifecycle":  jobrunners.Specials{Store: st},
+		"archive-stale-items": jobrunners.ArchiveStale{Store: st},
+	}
+	sched, err := jobs.NewScheduler(specs, runners, st, jobs.SystemClock{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := authn.NewKeyProvider(authn.KeyConfig{
+		KeyFile: cfg.JWTKeyFile,
+		KeyPEM:  cfg.JWTKeyPEM,
+		JWKSURL: cfg.JWTJWKSURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	verifier := authn.NewVerifier(keys, nil)
+
+	storage, err := uploads.NewFileStorage(cfg.UploadsDir)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := &images.Pipeline{Store: st, Storage: storage}
+	uploadsHandler := uploads.NewHandler(storage, func(ctx context.Context, id string, size int64) error {
+		f, err := storage.Open(id)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		_, err = pipeline.Ingest(data)
+		return err
+	})
+
+	app := &App{cfg: cfg, Store: st, Metrics: reg, Scheduler: sched, Verifier: verifier, Uploads: uploadsHandler}
+	if r, ok := keys.(authn.Reloadable); ok {
+		app.stopKeys = authn.WatchSIGHUP(r, "menu-service")
+	}
+	return app, nil
+}
+
+// Start binds the gRPC, HTTP, and admin listeners and begins serving, using
+// cfg's configured addresses ("" picks an ephemeral port for tests). It
+// returns once all listeners are bound; serving continues in goroutines.
+func (a *App) Start() error {
+	grpcLis, err := net.Listen("tcp", a.cfg.GRPCAddr)
+	if err != nil {
+		return err
+	}
+	a.grpcLis = grpcLis
+	a.grpcServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(a.Metrics.UnaryServerInterceptor(), a.Verifier.OptionalUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(a.Metrics.StreamServerInterceptor()),
+	)
+	menupb.RegisterMenuServiceServer(a.grpcServer, grpcapi.New(a.Store))
+	menuadminpb.RegisterMenuAdminServiceServer(a.grpcServer, adminapi.New(a.Scheduler))
+	go a.grpcServer.Serve(grpcLis)
+
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+	a.stopJobs = cancelJobs
+	a.Scheduler.Run(jobsCtx, jobTickInterval)
+
+	adminLis, err := net.Listen("tcp", a.cfg.AdminAddr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", a.Metrics.Handler())
+	a.adminSrv = &http.Server{Handler: mux}
+	go a.adminSrv.Serve(adminLis)
+	a.cfg.AdminAddr = adminLis.Addr().String()
+
+	httpLis, err := net.Listen("tcp", a.cfg.HTTPAddr)
+	if err != nil {
+		return err
+	}
+	a.httpServer = &http.Server{Handler: httpapi.NewRouter(a.Store, a.Metrics, a.Uploads)}
+	go a.httpServer.Serve(httpLis)
+	a.cfg.HTTPAddr = httpLis.Addr().String()
+	a.cfg.GRPCAddr = grpcLis.Addr().String()
+
+	return nil
+}
+
+// GRPCAddr returns the bound gRPC listener address, resolved after Start.
+func (a *App) GRPCAddr() string { return a.cfg.GRPCAddr }
+
+// HTTPAddr returns the bound HTTP listener address, resolved after Start.
+func (a *App) HTTPAddr() string { return a.cfg.HTTPAddr }
+
+// AdminAddr returns the bound admin listener address, resolved after Start.
+func (a *App) AdminAddr() string { return a.cfg.AdminAddr }
+
+// Stop gracefully shuts down all listeners and the job scheduler.
+func (a *App) Stop(ctx context.Context) {
+	if a.stopJobs != nil {
+		a.stopJobs()
+	}
+	if a.stopKeys != nil {
+		a.stopKeys()
+	}
+	if a.grpcServer != nil {
+		a.grpcServer.GracefulStop()
+	}
+	if a.httpServer != nil {
+		a.httpServer.Shutdown(ctx)
+	}
+	if a.adminSrv != nil {
+		a.adminSrv.Shutdown(ctx)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"log"
+
+	"menu-service/app"
+	"menu-service/config"
+)
+
+func main() {
+	a, err := app.New(config.FromEnv())
+	if err != nil {
+		log.Fatalf("menu-service: %v", err)
+	}
+	if err := a.Start(); err != nil {
+		log.Fatalf("menu-service: %v", err)
+	}
+	log.Printf("menu-service: grpc on %s, http on %s, admin on %s", a.GRPCAddr(), a.HTTPAddr(), a.AdminAddr())
+
+	select {}
+}
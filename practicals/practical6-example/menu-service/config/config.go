@@ -0,0 +1,42 @@
+package config
+
+import "os"
+
+// Config holds the runtime configuration for menu-service, sourced from
+// the environment so the same binary runs unmodified in dev and prod.
+type Config struct {
+	HTTPAddr   string
+	GRPCAddr   string
+	AdminAddr  string
+	DBDSN      string
+	JobsFile   string
+	UploadsDir string
+
+	JWTKeyFile string
+	JWTKeyPEM  string
+	JWTJWKSURL string
+}
+
+// FromEnv builds a Config from environment variables, falling back to
+// sensible local-dev defaults for anything unset.
+func FromEnv() Config {
+	return Config{
+		HTTPAddr:   getenv("MENU_HTTP_ADDR", ":8081"),
+		GRPCAddr:   getenv("MENU_GRPC_ADDR", ":9081"),
+		AdminAddr:  getenv("MENU_ADMIN_ADDR", ":9101"),
+		DBDSN:      getenv("MENU_DB_DSN", "menu.db"),
+		JobsFile:   getenv("MENU_JOBS_FILE", "jobs/jobs.yaml"),
+		UploadsDir: getenv("MENU_UPLOADS_DIR", "uploads"),
+
+		JWTKeyFile: getenv("MENU_JWT_KEY_FILE", ""),
+		JWTKeyPEM:  getenv("MENU_JWT_KEY_PEM", ""),
+		JWTJWKSURL: getenv("MENU_JWT_JWKS_URL", ""),
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,72 @@
+// Package adminapi implements the MenuAdminService gRPC surface ops use
+// to inspect and force-run menu-service's scheduled jobs.
+package adminapi
+
+import (
+	"context"
+
+	authn "github.com/douglasswm/cafe-authn"
+	menuadminpb "github.com/douglasswm/student-cafe-protos/menuadmin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"menu-service/pkg/jobs"
+)
+
+// Server implements menuadminpb.MenuAdminServiceServer against a
+// jobs.Scheduler. Every RPC is gated to the admin role: it's registered
+// on the same gRPC server as the public MenuService, behind the
+// OptionalUnaryServerInterceptor that lets unauthenticated callers
+// through to that service's public reads.
+type Server struct {
+	menuadminpb.UnimplementedMenuAdminServiceServer
+	Scheduler *jobs.Scheduler
+}
+
+// New constructs a gRPC MenuAdminService server backed by sched.
+func New(sched *jobs.Scheduler) *Server {
+	return &Server{Scheduler: sched}
+}
+
+func (s *Server) ListJobs(ctx context.Context, req *menuadminpb.ListJobsRequest) (*menuadminpb.ListJobsResponse, error) {
+	if err := authn.Require(ctx, authn.RoleAdmin); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	resp := &menuadminpb.ListJobsResponse{}
+	for _, spec := range s.Scheduler.ListJobs() {
+		resp.Jobs = append(resp.Jobs, &menuadminpb.JobInfo{Name: spec.Name, CronSpec: spec.CronSpec})
+	}
+	return resp, nil
+}
+
+func (s *Server) TriggerJob(ctx context.Context, req *menuadminpb.TriggerJobRequest) (*menuadminpb.TriggerJobResponse, error) {
+	if err := authn.Require(ctx, authn.RoleAdmin); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	rec, err := s.Scheduler.TriggerJob(ctx, req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	return &menuadminpb.TriggerJobResponse{Run: toProto(rec)}, nil
+}
+
+func (s *Server) GetLastRun(ctx context.Context, req *menuadminpb.GetLastRunRequest) (*menuadminpb.JobRun, error) {
+	if err := authn.Require(ctx, authn.RoleAdmin); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	rec, ok := s.Scheduler.LastRun(req.GetName())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %q has not run yet", req.GetName())
+	}
+	return toProto(rec), nil
+}
+
+func toProto(rec jobs.RunRecord) *menuadminpb.JobRun {
+	return &menuadminpb.JobRun{
+		JobName:            rec.JobName,
+		LastFireTimeUnixMs: rec.LastFireTime.UnixMilli(),
+		LastRunAtUnixMs:    rec.LastRunAt.UnixMilli(),
+		LastSuccess:        rec.LastSuccess,
+		LastError:          rec.LastError,
+	}
+}
@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authn "github.com/douglasswm/cafe-authn"
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+
+	"menu-service/internal/models"
+	"menu-service/internal/store"
+)
+
+// Server implements menupb.MenuServiceServer against a Store. Catalog
+// reads are public; UpdateItem and AttachImage are gated to the admin
+// role.
+type Server struct {
+	menupb.UnimplementedMenuServiceServer
+	Store *store.Store
+}
+
+// New constructs a gRPC MenuService server backed by st.
+func New(st *store.Store) *Server {
+	return &Server{Store: st}
+}
+
+func (s *Server) ListItems(ctx context.Context, req *menupb.ListItemsRequest) (*menupb.ListItemsResponse, error) {
+	items, err := s.Store.ListItems(req.GetAvailableOnly())
+	if err != nil {
+		return nil, err
+	}
+	resp := &menupb.ListItemsResponse{}
+	for _, it := range items {
+		resp.Items = append(resp.Items, toProto(it))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetItem(ctx context.Context, req *menupb.GetItemRequest) (*menupb.MenuItem, error) {
+	item, err := s.Store.GetItem(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toProto(*item), nil
+}
+
+func (s *Server) UpdateItem(ctx context.Context, req *menupb.UpdateItemRequest) (*menupb.MenuItem, error) {
+	if err := authn.Require(ctx, authn.RoleAdmin); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	item, err := s.Store.UpdateItem(req.GetId(), req.GetName(), req.GetPriceCents(), req.GetAvailable(), req.GetStock())
+	if err != nil {
+		return nil, err
+	}
+	return toProto(*item), nil
+}
+
+func (s *Server) AttachImage(ctx context.Context, req *menupb.AttachImageRequest) (*menupb.MenuItem, error) {
+	if err := authn.Require(ctx, authn.RoleAdmin); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	item, err := s.Store.AttachImage(req.GetItemId(), req.GetImageId())
+	if err != nil {
+		return nil, err
+	}
+	return toProto(*item), nil
+}
+
+func toProto(m models.MenuItem) *menupb.MenuItem {
+	p := &menupb.MenuItem{
+		Id:         m.ID,
+		Name:       m.Name,
+		PriceCents: m.PriceCents,
+		Available:  m.Available,
+		Stock:      m.Stock,
+	}
+	if m.ImageID != nil {
+		p.ImageId = *m.ImageID
+	}
+	return p
+}
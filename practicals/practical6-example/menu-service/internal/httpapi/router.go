@@ -0,0 +1,59 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	metrics "github.com/douglasswm/cafe-metrics"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"menu-service/internal/store"
+	"menu-service/pkg/uploads"
+)
+
+// NewRouter builds the chi router serving menu-service's public HTTP API.
+// uploadsHandler serves the tus protocol at /uploads; it does its own
+// path-based dispatch, so it's mounted directly rather than through chi's
+// param routing.
+func NewRouter(st *store.Store, reg *metrics.Registry, uploadsHandler *uploads.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Recoverer)
+	r.Use(reg.HTTPMiddleware)
+
+	r.Get("/menu-items", listItems(st))
+	r.Get("/menu-items/{id}", getItem(st))
+
+	r.Handle("/uploads", uploadsHandler)
+	r.Handle("/uploads/*", uploadsHandler)
+
+	return r
+}
+
+func listItems(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		items, err := st.ListItems(r.URL.Query().Get("available") == "true")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, items)
+	}
+}
+
+func getItem(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		item, err := st.GetItem(chi.URLParam(r, "id"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, item)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,126 @@
+// Package images validates and thumbnails menu-item photos once a tus
+// upload completes: a magic-byte sniff and max-dimension guard reject
+// anything that isn't a reasonably-sized PNG or JPEG, then the source is
+// re-encoded to WebP thumbnails at three sizes and stored content-
+// addressed so identical uploads dedupe to one row and one set of blobs.
+package images
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"sync"
+
+	"github.com/chai2010/webp"
+	"golang.org/x/image/draw"
+
+	"menu-service/internal/models"
+)
+
+// ThumbnailSizes are the square pixel dimensions generated for every
+// uploaded photo, matching the sizes menu-service's clients expect: a
+// card thumbnail, a detail-view image, and a full-size preview.
+var ThumbnailSizes = []int{128, 512, 1024}
+
+// maxDimension rejects absurdly large source images before they're
+// decoded into memory for resizing.
+const maxDimension = 8192
+
+// Store is the subset of store.Store the pipeline needs, kept narrow so
+// it can be faked in tests without a real database.
+type Store interface {
+	GetImageByHash(hash string) (*models.MenuItemImage, error)
+	CreateImage(img *models.MenuItemImage) error
+}
+
+// Storage is the subset of uploads.Storage the pipeline needs to persist
+// generated thumbnails.
+type Storage interface {
+	Put(key string, data []byte) error
+}
+
+// Pipeline turns raw uploaded bytes into a MenuItemImage row, deduping by
+// content hash. mu serializes the whole check-then-create sequence so
+// two uploads racing with identical content can't both decide "no
+// existing row" and create two.
+type Pipeline struct {
+	Store   Store
+	Storage Storage
+
+	mu sync.Mutex
+}
+
+// Ingest validates data as an image, and either returns the existing
+// MenuItemImage for its content hash or generates thumbnails, stores
+// them, and creates a new row.
+func (p *Pipeline) Ingest(data []byte) (*models.MenuItemImage, error) {
+	hash := contentHash(data)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if existing, err := p.Store.GetImageByHash(hash); err == nil && existing != nil {
+		return existing, nil
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("images: not a decodable image: %w", err)
+	}
+	if format != "png" && format != "jpeg" {
+		return nil, fmt.Errorf("images: unsupported format %q", format)
+	}
+	if cfg.Width > maxDimension || cfg.Height > maxDimension {
+		return nil, fmt.Errorf("images: %dx%d exceeds the %dpx maximum dimension", cfg.Width, cfg.Height, maxDimension)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("images: decode: %w", err)
+	}
+
+	img := &models.MenuItemImage{ID: hash, ContentHash: hash}
+	for _, size := range ThumbnailSizes {
+		thumb, err := encodeThumbnail(src, size)
+		if err != nil {
+			return nil, fmt.Errorf("images: thumbnail at %dpx: %w", size, err)
+		}
+		key := fmt.Sprintf("images/%s/%d.webp", hash, size)
+		if err := p.Storage.Put(key, thumb); err != nil {
+			return nil, fmt.Errorf("images: store thumbnail %s: %w", key, err)
+		}
+		switch size {
+		case 128:
+			img.Path128 = key
+		case 512:
+			img.Path512 = key
+		case 1024:
+			img.Path1024 = key
+		}
+	}
+
+	if err := p.Store.CreateImage(img); err != nil {
+		return nil, fmt.Errorf("images: create row: %w", err)
+	}
+	return img, nil
+}
+
+func encodeThumbnail(src image.Image, size int) ([]byte, error) {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := webp.Encode(&buf, dst, &webp.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
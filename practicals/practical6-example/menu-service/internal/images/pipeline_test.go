@@ -0,0 +1,110 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"menu-service/internal/models"
+)
+
+// fakeStore is an in-memory stand-in for store.Store's image methods,
+// guarded by its own lock since tests drive concurrent Ingest calls.
+type fakeStore struct {
+	mu     sync.Mutex
+	byHash map[string]*models.MenuItemImage
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{byHash: make(map[string]*models.MenuItemImage)}
+}
+
+func (f *fakeStore) GetImageByHash(hash string) (*models.MenuItemImage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.byHash[hash], nil
+}
+
+func (f *fakeStore) CreateImage(img *models.MenuItemImage) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.byHash[img.ContentHash] = img
+	return nil
+}
+
+// fakeStorage is an in-memory stand-in for uploads.Storage's Put, counting
+// writes per key so the dedup test can confirm a blob is only ever
+// written once.
+type fakeStorage struct {
+	mu   sync.Mutex
+	puts map[string]int
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{puts: make(map[string]int)}
+}
+
+func (f *fakeStorage) Put(key string, data []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.puts[key]++
+	return nil
+}
+
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 8), G: uint8(y * 8), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// TestConcurrentUploadsSameHashDedupe confirms two Ingest calls racing
+// with identical bytes settle on a single row and write each thumbnail
+// blob only once, rather than each deciding independently that no row
+// exists yet.
+func TestConcurrentUploadsSameHashDedupe(t *testing.T) {
+	store := newFakeStore()
+	storage := newFakeStorage()
+	pipeline := &Pipeline{Store: store, Storage: storage}
+	data := testPNG(t)
+
+	var wg sync.WaitGroup
+	results := make([]*models.MenuItemImage, 2)
+	for i := range results {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			img, err := pipeline.Ingest(data)
+			require.NoError(t, err)
+			results[i] = img
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, results[0].ID, results[1].ID)
+	require.Len(t, store.byHash, 1)
+	for _, count := range storage.puts {
+		require.Equal(t, 1, count)
+	}
+	require.Len(t, storage.puts, len(ThumbnailSizes))
+}
+
+// TestIngestRejectsUnsupportedFormat confirms the magic-byte/format check
+// actually rejects non-image input instead of falling through.
+func TestIngestRejectsUnsupportedFormat(t *testing.T) {
+	pipeline := &Pipeline{Store: newFakeStore(), Storage: newFakeStorage()}
+	_, err := pipeline.Ingest([]byte("not an image"))
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+// Package jobrunners implements jobs.Runner for menu-service's scheduled
+// jobs, backed by its Store.
+package jobrunners
+
+import (
+	"context"
+	"time"
+
+	"menu-service/internal/store"
+)
+
+// StockReplenishment resets menu items' stock back to their configured
+// defaults.
+type StockReplenishment struct {
+	Store *store.Store
+}
+
+// Run implements jobs.Runner.
+func (r StockReplenishment) Run(ctx context.Context, now time.Time, params map[string]string) error {
+	_, err := r.Store.ReplenishStock()
+	return err
+}
+
+// Specials publishes items whose available-from date has arrived and
+// unpublishes items whose available-until date has passed.
+type Specials struct {
+	Store *store.Store
+}
+
+// Run implements jobs.Runner.
+func (r Specials) Run(ctx context.Context, now time.Time, params map[string]string) error {
+	if _, err := r.Store.PublishDueSpecials(now); err != nil {
+		return err
+	}
+	_, err := r.Store.UnpublishExpiredSpecials(now)
+	return err
+}
+
+// defaultStaleAfter is how long an item must sit unavailable and out of
+// stock before ArchiveStale retires it, absent a stale_after param.
+const defaultStaleAfter = 7 * 24 * time.Hour
+
+// ArchiveStale archives menu items that have been unavailable and out of
+// stock for longer than the stale_after param (a Go duration string,
+// e.g. "168h"), or defaultStaleAfter if unset or unparsable.
+type ArchiveStale struct {
+	Store *store.Store
+}
+
+// Run implements jobs.Runner.
+func (r ArchiveStale) Run(ctx context.Context, now time.Time, params map[string]string) error {
+	staleAfter := defaultStaleAfter
+	if v, ok := params["stale_after"]; ok {
+		if d, err := time.ParseDuration(v); err == nil {
+			staleAfter = d
+		}
+	}
+	_, err := r.Store.ArchiveStaleItems(now.Add(-staleAfter))
+	return err
+}
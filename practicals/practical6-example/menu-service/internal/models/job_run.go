@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// JobRun persists the last completed window for one scheduled job, so a
+// restart can tell it already ran for that window instead of firing
+// again.
+type JobRun struct {
+	JobName      string `gorm:"primaryKey"`
+	LastFireTime time.Time
+	LastRunAt    time.Time
+	LastSuccess  bool
+	LastError    string
+}
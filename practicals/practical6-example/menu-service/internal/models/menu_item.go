@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// MenuItem is the persisted representation of a cafe menu item.
+type MenuItem struct {
+	ID         string `gorm:"primaryKey"`
+	Name       string
+	PriceCents int64
+	Available  bool
+	Stock      int32
+
+	// AvailableFrom and AvailableUntil bound a time-limited special: the
+	// specials-lifecycle job publishes the item (sets Available) once
+	// AvailableFrom arrives and unpublishes it once AvailableUntil
+	// passes. Either may be nil for an item with no lifecycle window.
+	AvailableFrom  *time.Time
+	AvailableUntil *time.Time
+
+	// Archived hides an item from the public catalog once the
+	// archive-stale-items job has retired it.
+	Archived bool
+
+	// ImageID references the MenuItemImage attached via AttachImage, if
+	// any. Nil means the item has no photo yet.
+	ImageID *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
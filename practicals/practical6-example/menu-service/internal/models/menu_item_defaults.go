@@ -0,0 +1,8 @@
+package models
+
+// MenuItemDefaults holds the baseline stock level menu-service resets a
+// menu item to during the nightly stock-replenishment job.
+type MenuItemDefaults struct {
+	MenuItemID   string `gorm:"primaryKey"`
+	DefaultStock int32
+}
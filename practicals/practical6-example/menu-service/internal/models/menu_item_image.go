@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// MenuItemImage is a processed photo uploaded through the tus endpoint at
+// /uploads: its three WebP thumbnails are content-addressed by
+// ContentHash, so re-uploading identical bytes reuses the existing row
+// and blobs instead of storing them again.
+type MenuItemImage struct {
+	ID          string `gorm:"primaryKey"`
+	ContentHash string `gorm:"uniqueIndex"`
+
+	// Path128/512/1024 are Storage keys for the WebP thumbnail at each
+	// size. The /uploads route does not serve them: it only accepts tus
+	// creation, HEAD, and PATCH requests.
+	Path128  string
+	Path512  string
+	Path1024 string
+
+	CreatedAt time.Time
+}
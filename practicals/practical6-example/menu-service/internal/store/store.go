@@ -0,0 +1,202 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	metrics "github.com/douglasswm/cafe-metrics"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"menu-service/internal/models"
+	"menu-service/pkg/jobs"
+)
+
+// Store wraps the GORM handle used by menu-service to reach its database.
+type Store struct {
+	DB *gorm.DB
+}
+
+// Open connects to dsn (a sqlite file path in dev, a postgres DSN in prod),
+// runs the auto-migrations menu-service owns, and wires reg's GORM callbacks
+// so every call is reflected in db_call_duration_seconds.
+func Open(dsn string, reg *metrics.Registry) (*Store, error) {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&models.MenuItem{}, &models.MenuItemDefaults{}, &models.JobRun{}, &models.MenuItemImage{}); err != nil {
+		return nil, err
+	}
+	if reg != nil {
+		if err := reg.RegisterGORMCallbacks(db); err != nil {
+			return nil, err
+		}
+	}
+	return &Store{DB: db}, nil
+}
+
+// ListItems returns non-archived menu items, optionally filtered to those
+// marked available.
+func (s *Store) ListItems(availableOnly bool) ([]models.MenuItem, error) {
+	var items []models.MenuItem
+	q := s.DB.Where("archived = ?", false)
+	if availableOnly {
+		q = q.Where("available = ?", true)
+	}
+	if err := q.Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetItem looks up a single menu item by ID.
+func (s *Store) GetItem(id string) (*models.MenuItem, error) {
+	var item models.MenuItem
+	if err := s.DB.First(&item, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// UpdateItem overwrites an existing item's editable fields and returns its
+// new state; only reachable through the admin-gated UpdateItem RPC.
+func (s *Store) UpdateItem(id, name string, priceCents int64, available bool, stock int32) (*models.MenuItem, error) {
+	var item models.MenuItem
+	if err := s.DB.First(&item, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	item.Name = name
+	item.PriceCents = priceCents
+	item.Available = available
+	item.Stock = stock
+	if err := s.DB.Save(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetImageByHash looks up a previously processed image by its content
+// hash, returning (nil, nil) if none exists yet so callers can use it
+// directly as a dedup check.
+func (s *Store) GetImageByHash(hash string) (*models.MenuItemImage, error) {
+	var img models.MenuItemImage
+	err := s.DB.First(&img, "content_hash = ?", hash).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &img, nil
+}
+
+// CreateImage persists a newly processed image row.
+func (s *Store) CreateImage(img *models.MenuItemImage) error {
+	return s.DB.Create(img).Error
+}
+
+// AttachImage points item at image, overwriting any photo it already had.
+func (s *Store) AttachImage(itemID, imageID string) (*models.MenuItem, error) {
+	var item models.MenuItem
+	if err := s.DB.First(&item, "id = ?", itemID).Error; err != nil {
+		return nil, err
+	}
+	item.ImageID = &imageID
+	if err := s.DB.Save(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ReplenishStock resets every menu item below its configured default
+// stock level back up to that default, and marks it available again. It
+// returns the number of items touched.
+func (s *Store) ReplenishStock() (int, error) {
+	var defaults []models.MenuItemDefaults
+	if err := s.DB.Find(&defaults).Error; err != nil {
+		return 0, err
+	}
+
+	touched := 0
+	for _, d := range defaults {
+		res := s.DB.Model(&models.MenuItem{}).
+			Where("id = ? AND stock < ?", d.MenuItemID, d.DefaultStock).
+			Updates(map[string]interface{}{"stock": d.DefaultStock, "available": true})
+		if res.Error != nil {
+			return touched, res.Error
+		}
+		touched += int(res.RowsAffected)
+	}
+	return touched, nil
+}
+
+// PublishDueSpecials marks available any unavailable item whose
+// AvailableFrom has arrived and whose AvailableUntil (if set) hasn't
+// passed yet. It returns the number of items published.
+func (s *Store) PublishDueSpecials(now time.Time) (int, error) {
+	res := s.DB.Model(&models.MenuItem{}).
+		Where("available = ? AND available_from IS NOT NULL AND available_from <= ?", false, now).
+		Where("available_until IS NULL OR available_until > ?", now).
+		Update("available", true)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return int(res.RowsAffected), nil
+}
+
+// UnpublishExpiredSpecials marks unavailable any available item whose
+// AvailableUntil has passed. It returns the number of items unpublished.
+func (s *Store) UnpublishExpiredSpecials(now time.Time) (int, error) {
+	res := s.DB.Model(&models.MenuItem{}).
+		Where("available = ? AND available_until IS NOT NULL AND available_until <= ?", true, now).
+		Update("available", false)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return int(res.RowsAffected), nil
+}
+
+// ArchiveStaleItems archives every unavailable, out-of-stock item that
+// hasn't been touched since before cutoff. It returns the number of
+// items archived.
+func (s *Store) ArchiveStaleItems(cutoff time.Time) (int, error) {
+	res := s.DB.Model(&models.MenuItem{}).
+		Where("archived = ? AND available = ? AND stock = 0 AND updated_at <= ?", false, false, cutoff).
+		Update("archived", true)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return int(res.RowsAffected), nil
+}
+
+// GetRunRecord implements jobs.RunStore.
+func (s *Store) GetRunRecord(jobName string) (*jobs.RunRecord, error) {
+	var run models.JobRun
+	err := s.DB.First(&run, "job_name = ?", jobName).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &jobs.RunRecord{
+		JobName:      run.JobName,
+		LastFireTime: run.LastFireTime,
+		LastRunAt:    run.LastRunAt,
+		LastSuccess:  run.LastSuccess,
+		LastError:    run.LastError,
+	}, nil
+}
+
+// SaveRunRecord implements jobs.RunStore.
+func (s *Store) SaveRunRecord(rec jobs.RunRecord) error {
+	run := models.JobRun{
+		JobName:      rec.JobName,
+		LastFireTime: rec.LastFireTime,
+		LastRunAt:    rec.LastRunAt,
+		LastSuccess:  rec.LastSuccess,
+		LastError:    rec.LastError,
+	}
+	return s.DB.Save(&run).Error
+}
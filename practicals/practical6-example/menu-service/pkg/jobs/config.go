@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// file is the top-level shape of a jobs YAML file.
+type file struct {
+	Jobs []Spec `yaml:"jobs"`
+}
+
+// LoadSpecs reads job declarations from the YAML file at path. An empty
+// path means no jobs file is configured, so it returns an empty set
+// rather than erroring.
+func LoadSpecs(path string) ([]Spec, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f file
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return f.Jobs, nil
+}
@@ -0,0 +1,59 @@
+// Package jobs implements a declaratively configured scheduler for
+// menu-service's background jobs (stock replenishment, specials
+// publishing, stale-item archiving). Cron expressions are parsed with
+// github.com/robfig/cron/v3, but windows are computed and fired by
+// Scheduler.Tick rather than cron's own goroutine runner, so tests can
+// fast-forward through a simulated day with a fake Clock instead of
+// sleeping.
+package jobs
+
+import (
+	"context"
+	"time"
+)
+
+// Clock abstracts time.Now so Scheduler can be driven by a fake clock in
+// tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the production Clock, backed by time.Now.
+type SystemClock struct{}
+
+// Now returns the current wall-clock time.
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// Spec declaratively configures one scheduled job: when it runs (a
+// standard five-field cron expression) and the parameters passed to its
+// Runner.
+type Spec struct {
+	Name     string            `yaml:"name"`
+	CronSpec string            `yaml:"cron"`
+	Params   map[string]string `yaml:"params"`
+}
+
+// Runner performs one job's work for a single scheduled window. now is
+// the time the window fired at (the scheduled tick in production, or the
+// moment TriggerJob was called).
+type Runner interface {
+	Run(ctx context.Context, now time.Time, params map[string]string) error
+}
+
+// RunRecord is one job's persisted last-run bookkeeping.
+type RunRecord struct {
+	JobName      string
+	LastFireTime time.Time
+	LastRunAt    time.Time
+	LastSuccess  bool
+	LastError    string
+}
+
+// RunStore persists RunRecords so a restart can tell which scheduled
+// window a job last fired for, instead of re-running it.
+type RunStore interface {
+	// GetRunRecord returns the persisted record for jobName, or (nil, nil)
+	// if the job has never run.
+	GetRunRecord(jobName string) (*RunRecord, error)
+	SaveRunRecord(rec RunRecord) error
+}
@@ -0,0 +1,176 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+type registeredJob struct {
+	spec     Spec
+	schedule cron.Schedule
+	runner   Runner
+
+	// initialFrom is the reference point used to find a job's first due
+	// window when it has never run before: the instant just before
+	// construction, fixed once so re-evaluating it on every Tick can't
+	// shift which window counts as "first".
+	initialFrom time.Time
+}
+
+// Scheduler runs a fixed set of declaratively configured jobs. It parses
+// each job's cron expression once at construction time with
+// github.com/robfig/cron/v3, then on every Tick computes for itself
+// whether that job's next scheduled window has arrived, rather than
+// depending on cron.Cron's own background goroutine.
+type Scheduler struct {
+	clock Clock
+	store RunStore
+
+	mu   sync.Mutex
+	jobs []*registeredJob
+	last map[string]RunRecord
+}
+
+// NewScheduler parses specs' cron expressions, pairs each with the Runner
+// registered under its name in runners, and loads any persisted run
+// history from store so a process restart resumes from the last
+// completed window instead of firing it again.
+func NewScheduler(specs []Spec, runners map[string]Runner, store RunStore, clock Clock) (*Scheduler, error) {
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	s := &Scheduler{clock: clock, store: store, last: make(map[string]RunRecord)}
+
+	initialFrom := clock.Now().Add(-time.Nanosecond)
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	for _, spec := range specs {
+		runner, ok := runners[spec.Name]
+		if !ok {
+			return nil, fmt.Errorf("jobs: no runner registered for job %q", spec.Name)
+		}
+		schedule, err := parser.Parse(spec.CronSpec)
+		if err != nil {
+			return nil, fmt.Errorf("jobs: parse cron spec for %q: %w", spec.Name, err)
+		}
+		s.jobs = append(s.jobs, &registeredJob{spec: spec, schedule: schedule, runner: runner, initialFrom: initialFrom})
+
+		rec, err := store.GetRunRecord(spec.Name)
+		if err != nil {
+			return nil, fmt.Errorf("jobs: load run record for %q: %w", spec.Name, err)
+		}
+		if rec != nil {
+			s.last[spec.Name] = *rec
+		}
+	}
+	return s, nil
+}
+
+// Run starts a goroutine that calls Tick on interval until ctx is
+// cancelled.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Tick(ctx, s.clock.Now())
+			}
+		}
+	}()
+}
+
+// Tick runs every job whose next scheduled window at or before now
+// hasn't already been recorded as run. Calling Tick repeatedly with
+// overlapping or identical now values is safe: a job only fires once per
+// window no matter how often Tick observes it, which is what keeps a
+// restart from double-firing a job that already ran for the current
+// window.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	jobs := append([]*registeredJob(nil), s.jobs...)
+	s.mu.Unlock()
+
+	for _, j := range jobs {
+		s.fireIfDue(ctx, j, now)
+	}
+}
+
+func (s *Scheduler) fireIfDue(ctx context.Context, j *registeredJob, now time.Time) {
+	s.mu.Lock()
+	last, ok := s.last[j.spec.Name]
+	s.mu.Unlock()
+
+	from := last.LastFireTime
+	if !ok {
+		from = j.initialFrom
+	}
+	next := j.schedule.Next(from)
+	if next.After(now) {
+		return
+	}
+
+	s.run(ctx, j, next, now)
+}
+
+// TriggerJob runs name immediately, independent of its schedule, and
+// records the result exactly like a normal firing would.
+func (s *Scheduler) TriggerJob(ctx context.Context, name string) (RunRecord, error) {
+	s.mu.Lock()
+	var job *registeredJob
+	for _, j := range s.jobs {
+		if j.spec.Name == name {
+			job = j
+			break
+		}
+	}
+	s.mu.Unlock()
+	if job == nil {
+		return RunRecord{}, fmt.Errorf("jobs: unknown job %q", name)
+	}
+
+	now := s.clock.Now()
+	return s.run(ctx, job, now, now), nil
+}
+
+func (s *Scheduler) run(ctx context.Context, j *registeredJob, fireTime, now time.Time) RunRecord {
+	rec := RunRecord{JobName: j.spec.Name, LastFireTime: fireTime, LastRunAt: now}
+	if err := j.runner.Run(ctx, now, j.spec.Params); err != nil {
+		rec.LastError = err.Error()
+	} else {
+		rec.LastSuccess = true
+	}
+
+	s.mu.Lock()
+	s.last[j.spec.Name] = rec
+	s.mu.Unlock()
+	s.store.SaveRunRecord(rec)
+
+	return rec
+}
+
+// ListJobs returns the configured spec for every registered job.
+func (s *Scheduler) ListJobs() []Spec {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	specs := make([]Spec, len(s.jobs))
+	for i, j := range s.jobs {
+		specs[i] = j.spec
+	}
+	return specs
+}
+
+// LastRun returns the most recent run record for name, or false if it
+// has never run.
+func (s *Scheduler) LastRun(name string) (RunRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.last[name]
+	return rec, ok
+}
@@ -0,0 +1,149 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+type memRunStore struct {
+	mu      sync.Mutex
+	records map[string]RunRecord
+}
+
+func newMemRunStore() *memRunStore {
+	return &memRunStore{records: make(map[string]RunRecord)}
+}
+
+func (s *memRunStore) GetRunRecord(jobName string) (*RunRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[jobName]
+	if !ok {
+		return nil, nil
+	}
+	return &rec, nil
+}
+
+func (s *memRunStore) SaveRunRecord(rec RunRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[rec.JobName] = rec
+	return nil
+}
+
+type countingRunner struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (r *countingRunner) Run(ctx context.Context, now time.Time, params map[string]string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	return nil
+}
+
+func (r *countingRunner) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func TestSchedulerFiresOncePerWindow(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 1, 59, 0, 0, time.UTC)}
+	runner := &countingRunner{}
+	store := newMemRunStore()
+
+	sched, err := NewScheduler(
+		[]Spec{{Name: "nightly", CronSpec: "0 2 * * *"}},
+		map[string]Runner{"nightly": runner},
+		store,
+		clock,
+	)
+	require.NoError(t, err)
+
+	sched.Tick(context.Background(), clock.Now())
+	require.Equal(t, 0, runner.count(), "job is not due yet at 01:59")
+
+	clock.set(time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC))
+	sched.Tick(context.Background(), clock.Now())
+	require.Equal(t, 1, runner.count())
+
+	// Further ticks before the next day's 02:00 window must not re-fire.
+	clock.set(time.Date(2026, 3, 1, 2, 30, 0, 0, time.UTC))
+	sched.Tick(context.Background(), clock.Now())
+	require.Equal(t, 1, runner.count())
+
+	clock.set(time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC))
+	sched.Tick(context.Background(), clock.Now())
+	require.Equal(t, 2, runner.count(), "the next day's window should fire")
+}
+
+func TestSchedulerRestartDoesNotDoubleFire(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)}
+	runner := &countingRunner{}
+	store := newMemRunStore()
+
+	spec := []Spec{{Name: "nightly", CronSpec: "0 2 * * *"}}
+	runners := map[string]Runner{"nightly": runner}
+
+	first, err := NewScheduler(spec, runners, store, clock)
+	require.NoError(t, err)
+	first.Tick(context.Background(), clock.Now())
+	require.Equal(t, 1, runner.count())
+
+	// Simulate a restart: a brand new Scheduler loads last-run state from
+	// the same store, then ticks again within the same 02:00 window.
+	second, err := NewScheduler(spec, runners, store, clock)
+	require.NoError(t, err)
+	second.Tick(context.Background(), clock.Now())
+	require.Equal(t, 1, runner.count(), "restarting within the same window must not re-fire the job")
+}
+
+func TestSchedulerFastForwardThroughASimulatedDay(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	stock := &countingRunner{}
+	specials := &countingRunner{}
+	store := newMemRunStore()
+
+	sched, err := NewScheduler(
+		[]Spec{
+			{Name: "stock-replenishment", CronSpec: "0 2 * * *"},
+			{Name: "specials-lifecycle", CronSpec: "*/15 * * * *"},
+		},
+		map[string]Runner{"stock-replenishment": stock, "specials-lifecycle": specials},
+		store,
+		clock,
+	)
+	require.NoError(t, err)
+
+	start := clock.Now()
+	for d := time.Duration(0); d < 24*time.Hour; d += time.Minute {
+		clock.set(start.Add(d))
+		sched.Tick(context.Background(), clock.Now())
+	}
+
+	require.Equal(t, 1, stock.count(), "stock replenishment runs once per day")
+	require.Equal(t, 96, specials.count(), "specials lifecycle runs every 15 minutes across a full day")
+}
@@ -0,0 +1,159 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// tusVersion is the protocol version this Handler implements.
+const tusVersion = "1.0.0"
+
+// meta tracks one upload's declared size and bytes received so far.
+type meta struct {
+	length int64
+	offset int64
+}
+
+// OnFinish is called once an upload's final byte has been written, with
+// the completed upload's ID and total size. menu-service uses this to run
+// image validation, thumbnailing, and dedup before the client's PATCH
+// request returns.
+type OnFinish func(ctx context.Context, id string, size int64) error
+
+// Handler serves the tus protocol's creation, HEAD, and PATCH requests
+// over Storage, calling OnFinish synchronously when an upload completes.
+type Handler struct {
+	Storage  Storage
+	OnFinish OnFinish
+
+	mu      sync.Mutex
+	uploads map[string]*meta
+}
+
+// NewHandler builds a Handler backed by storage. onFinish may be nil if
+// the caller doesn't need a completion hook.
+func NewHandler(storage Storage, onFinish OnFinish) *Handler {
+	return &Handler{Storage: storage, OnFinish: onFinish, uploads: make(map[string]*meta)}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+
+	id, hasID := uploadID(r.URL.Path)
+	switch {
+	case r.Method == http.MethodPost && !hasID:
+		h.create(w, r)
+	case r.Method == http.MethodHead && hasID:
+		h.status(w, id)
+	case r.Method == http.MethodPatch && hasID:
+		h.patch(w, r, id)
+	default:
+		http.Error(w, "unsupported tus request", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length < 0 {
+		http.Error(w, "missing or invalid Upload-Length", http.StatusBadRequest)
+		return
+	}
+
+	id := uuid.NewString()
+	if err := h.Storage.Create(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.mu.Lock()
+	h.uploads[id] = &meta{length: length}
+	h.mu.Unlock()
+
+	w.Header().Set("Location", fmt.Sprintf("/uploads/%s", id))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) status(w http.ResponseWriter, id string) {
+	h.mu.Lock()
+	m, ok := h.uploads[id]
+	h.mu.Unlock()
+	if !ok {
+		http.Error(w, "unknown upload", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Upload-Offset", strconv.FormatInt(m.offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(m.length, 10))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) patch(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Header.Get("Content-Type") != "application/offset+octet-stream" {
+		http.Error(w, "unsupported Content-Type", http.StatusUnsupportedMediaType)
+		return
+	}
+	sentOffset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		http.Error(w, "missing or invalid Upload-Offset", http.StatusBadRequest)
+		return
+	}
+
+	h.mu.Lock()
+	m, ok := h.uploads[id]
+	h.mu.Unlock()
+	if !ok {
+		http.Error(w, "unknown upload", http.StatusNotFound)
+		return
+	}
+	if sentOffset != m.offset {
+		http.Error(w, "Upload-Offset does not match current offset", http.StatusConflict)
+		return
+	}
+
+	body := make([]byte, m.length-m.offset)
+	n, err := io.ReadFull(r.Body, body)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	body = body[:n]
+
+	written, err := h.Storage.WriteAt(id, m.offset, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.mu.Lock()
+	m.offset += int64(written)
+	finished := m.offset >= m.length
+	newOffset := m.offset
+	h.mu.Unlock()
+
+	if finished && h.OnFinish != nil {
+		if err := h.OnFinish(r.Context(), id, newOffset); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Upload-Offset", strconv.FormatInt(newOffset, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// uploadID splits "/uploads" (no ID) from "/uploads/<id>", mirroring how
+// httpapi mounts Handler at the /uploads prefix.
+func uploadID(path string) (string, bool) {
+	const prefix = "/uploads/"
+	if len(path) <= len(prefix) || path[:len(prefix)] != prefix {
+		return "", false
+	}
+	return path[len(prefix):], true
+}
@@ -0,0 +1,88 @@
+package uploads
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandler(t *testing.T) (*Handler, *FileStorage) {
+	t.Helper()
+	storage, err := NewFileStorage(t.TempDir())
+	require.NoError(t, err)
+	return NewHandler(storage, nil), storage
+}
+
+// TestResumableUploadSurvivesInterruption simulates a client that sends
+// the first half of an upload, "drops" (no further requests for a while),
+// then resumes from the offset reported by HEAD and completes it. The
+// final stored bytes must match the original content exactly.
+func TestResumableUploadSurvivesInterruption(t *testing.T) {
+	h, storage := newTestHandler(t)
+	content := bytes.Repeat([]byte("menu-item-photo-bytes-"), 100)
+
+	createReq := httptest.NewRequest(http.MethodPost, "/uploads", nil)
+	createReq.Header.Set("Upload-Length", strconv.Itoa(len(content)))
+	createRec := httptest.NewRecorder()
+	h.ServeHTTP(createRec, createReq)
+	require.Equal(t, http.StatusCreated, createRec.Code)
+	location := createRec.Header().Get("Location")
+	require.NotEmpty(t, location)
+
+	half := len(content) / 2
+	patchChunk(t, h, location, 0, content[:half])
+
+	// Simulate the interruption: the client disappears, then comes back
+	// and asks where it left off instead of assuming.
+	headReq := httptest.NewRequest(http.MethodHead, location, nil)
+	headRec := httptest.NewRecorder()
+	h.ServeHTTP(headRec, headReq)
+	require.Equal(t, http.StatusOK, headRec.Code)
+	require.Equal(t, strconv.Itoa(half), headRec.Header().Get("Upload-Offset"))
+
+	patchChunk(t, h, location, int64(half), content[half:])
+
+	id := location[len("/uploads/"):]
+	stored, err := storage.Open(id)
+	require.NoError(t, err)
+	defer stored.Close()
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(stored)
+	require.NoError(t, err)
+	require.Equal(t, content, buf.Bytes())
+}
+
+func patchChunk(t *testing.T, h *Handler, location string, offset int64, chunk []byte) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPatch, location, bytes.NewReader(chunk))
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+// TestPatchRejectsWrongOffset confirms a client that lost track of its
+// own progress (and PATCHes at the wrong offset) gets a 409 rather than
+// silently corrupting the stored upload.
+func TestPatchRejectsWrongOffset(t *testing.T) {
+	h, _ := newTestHandler(t)
+	content := []byte("hello")
+
+	createReq := httptest.NewRequest(http.MethodPost, "/uploads", nil)
+	createReq.Header.Set("Upload-Length", strconv.Itoa(len(content)))
+	createRec := httptest.NewRecorder()
+	h.ServeHTTP(createRec, createReq)
+	location := createRec.Header().Get("Location")
+
+	req := httptest.NewRequest(http.MethodPatch, location, bytes.NewReader(content))
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", "2")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusConflict, rec.Code)
+}
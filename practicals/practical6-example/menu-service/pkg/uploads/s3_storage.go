@@ -0,0 +1,73 @@
+package uploads
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// S3Client is the narrow slice of an S3-compatible SDK client S3Storage
+// needs, so this package doesn't depend on a specific AWS SDK version —
+// callers wire in e.g. an *s3.Client from aws-sdk-go-v2 via a thin
+// adapter.
+type S3Client interface {
+	PutObject(ctx context.Context, bucket, key string, body io.Reader) error
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+}
+
+// S3Storage is the production Storage backend: uploads and derived
+// thumbnails are objects in a single bucket, keyed the same way
+// FileStorage keys local files.
+type S3Storage struct {
+	Client S3Client
+	Bucket string
+}
+
+// NewS3Storage wraps client for bucket.
+func NewS3Storage(client S3Client, bucket string) *S3Storage {
+	return &S3Storage{Client: client, Bucket: bucket}
+}
+
+func (s *S3Storage) Create(id string) error {
+	return s.Client.PutObject(context.Background(), s.Bucket, id, bytes.NewReader(nil))
+}
+
+// WriteAt fetches the object, overlays p at offset, and re-puts it. S3
+// has no partial-write API, so resumable uploads pay an O(size)
+// read-modify-write per PATCH; acceptable for menu-item photos, which are
+// small and chunked in only a handful of requests.
+func (s *S3Storage) WriteAt(id string, offset int64, p []byte) (int, error) {
+	existing, err := s.readAll(id)
+	if err != nil {
+		return 0, err
+	}
+	if need := int(offset) + len(p); need > len(existing) {
+		existing = append(existing, make([]byte, need-len(existing))...)
+	}
+	copy(existing[offset:], p)
+	if err := s.Client.PutObject(context.Background(), s.Bucket, id, bytes.NewReader(existing)); err != nil {
+		return 0, fmt.Errorf("uploads: put %s: %w", id, err)
+	}
+	return len(p), nil
+}
+
+func (s *S3Storage) Open(id string) (io.ReadCloser, error) {
+	return s.Client.GetObject(context.Background(), s.Bucket, id)
+}
+
+func (s *S3Storage) Put(key string, data []byte) error {
+	if err := s.Client.PutObject(context.Background(), s.Bucket, key, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("uploads: put %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3Storage) readAll(id string) ([]byte, error) {
+	r, err := s.Client.GetObject(context.Background(), s.Bucket, id)
+	if err != nil {
+		return nil, nil
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,87 @@
+// Package uploads implements the core of the tus resumable-upload
+// protocol (https://tus.io; see github.com/tus/tusd for the reference
+// server) against a pluggable Storage backend. Only the subset
+// menu-service needs — creation, HEAD, and PATCH — is implemented;
+// extensions like checksum or expiration aren't.
+package uploads
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Storage persists the bytes of an in-progress or completed upload,
+// addressed by the upload ID Handler assigns on creation. Implementations
+// must support writing at an arbitrary offset so a PATCH that resumes a
+// partial upload doesn't need to re-send earlier bytes.
+type Storage interface {
+	// Create reserves empty storage for a new upload under id.
+	Create(id string) error
+	// WriteAt appends p at offset (always the upload's current offset)
+	// and returns the number of bytes written.
+	WriteAt(id string, offset int64, p []byte) (int, error)
+	// Open returns the completed upload's contents for the post-finish
+	// hook to read.
+	Open(id string) (io.ReadCloser, error)
+	// Put stores an arbitrary named blob (e.g. a generated thumbnail)
+	// under key, for backends shared between raw uploads and derived
+	// assets.
+	Put(key string, data []byte) error
+}
+
+// FileStorage stores uploads and derived blobs as files under Dir. It's
+// the local-dev backend; an S3-compatible Storage is a separate
+// implementation of the same interface for production.
+type FileStorage struct {
+	Dir string
+}
+
+// NewFileStorage creates dir if needed and returns a FileStorage rooted
+// there.
+func NewFileStorage(dir string) (*FileStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("uploads: create storage dir %s: %w", dir, err)
+	}
+	return &FileStorage{Dir: dir}, nil
+}
+
+func (s *FileStorage) path(key string) string {
+	return filepath.Join(s.Dir, filepath.FromSlash(key))
+}
+
+func (s *FileStorage) Create(id string) error {
+	f, err := os.OpenFile(s.path(id), os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("uploads: create %s: %w", id, err)
+	}
+	return f.Close()
+}
+
+func (s *FileStorage) WriteAt(id string, offset int64, p []byte) (int, error) {
+	f, err := os.OpenFile(s.path(id), os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("uploads: open %s: %w", id, err)
+	}
+	defer f.Close()
+	return f.WriteAt(p, offset)
+}
+
+func (s *FileStorage) Open(id string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(id))
+	if err != nil {
+		return nil, fmt.Errorf("uploads: open %s: %w", id, err)
+	}
+	return f, nil
+}
+
+func (s *FileStorage) Put(key string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(s.path(key)), 0o755); err != nil {
+		return fmt.Errorf("uploads: create dir for %s: %w", key, err)
+	}
+	if err := os.WriteFile(s.path(key), data, 0o644); err != nil {
+		return fmt.Errorf("uploads: write %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Package app wires order-service's dependencies together so both the
+// production entrypoint (cmd/server) and integration tests can start and
+// stop a fully configured instance in-process.
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	authn "github.com/douglasswm/cafe-authn"
+	metrics "github.com/douglasswm/cafe-metrics"
+	orderpb "github.com/douglasswm/student-cafe-protos/order"
+	"google.golang.org/grpc"
+
+	"order-service/config"
+	"order-service/internal/clients"
+	"order-service/internal/grpcapi"
+	"order-service/internal/httpapi"
+	"order-service/internal/store"
+	"order-service/pkg/events"
+	"order-service/pkg/rules"
+)
+
+// App is a fully wired order-service instance.
+type App struct {
+	cfg      config.Config
+	Store    *store.Store
+	Metrics  *metrics.Registry
+	Clients  *clients.Clients
+	Rules    *rules.Engine
+	Hub      *events.Hub
+	Verifier *authn.Verifier
+
+	grpcServer *grpc.Server
+	httpServer *http.Server
+	adminSrv   *http.Server
+	stopRules  func()
+	stopKeys   func()
+}
+
+// New builds an App from cfg: it opens the store, registers metrics, dials
+// menu-service and user-service for rule context, loads the CEL rule file,
+// and creates the status-change Hub shared by WatchOrder and the WebSocket
+// endpoint, but binds no listeners yet.
+func New(cfg config.Config) (*App, error) {
+	reg := metrics.NewRegistry("order-service")
+	hub := events.NewHub()
+
+	st, err := store.Open(cfg.DBDSN, reg, hub)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := clients.Dial(cfg.MenuServiceAddr, cfg.UserServiceAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := rules.LoadEngine(cfg.RulesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := authn.NewKeyProvider(authn.KeyConfig{
+		KeyFile: cfg.JWTKeyFile,
+		KeyPEM:  cfg.JWTKeyPEM,
+		JWKSURL: cfg.JWTJWKSURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	verifier := authn.NewVerifier(keys, nil)
+
+	app := &App{cfg: cfg, Store: st, Metrics: reg, Clients: cl, Rules: re, Hub: hub, Verifier: verifier}
+	if r, ok := keys.(authn.Reloadable); ok {
+		app.stopKeys = authn.WatchSIGHUP(r, "order-service")
+	}
+	return app, nil
+}
+
+// Start binds the gRPC, HTTP, and admin listeners and begins serving, using
+// cfg's configured addresses ("" picks an ephemeral port for tests). It
+// returns once all listeners are bound; serving continues in goroutines.
+func (a *App) Start() error {
+	grpcLis, err := net.Listen("tcp", a.cfg.GRPCAddr)
+	if err != nil {
+		return err
+	}
+	a.grpcServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(a.Metrics.UnaryServerInterceptor(), a.Verifier.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(a.Metrics.StreamServerInterceptor(), a.Verifier.StreamServerInterceptor()),
+	)
+	orderpb.RegisterOrderServiceServer(a.grpcServer, grpcapi.New(a.Store, a.Clients, a.Rules, a.Hub))
+	go a.grpcServer.Serve(grpcLis)
+	a.cfg.GRPCAddr = grpcLis.Addr().String()
+
+	a.stopRules = a.Rules.WatchSIGHUP()
+
+	adminLis, err := net.Listen("tcp", a.cfg.AdminAddr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", a.Metrics.Handler())
+	a.adminSrv = &http.Server{Handler: mux}
+	go a.adminSrv.Serve(adminLis)
+	a.cfg.AdminAddr = adminLis.Addr().String()
+
+	httpLis, err := net.Listen("tcp", a.cfg.HTTPAddr)
+	if err != nil {
+		return err
+	}
+	a.httpServer = &http.Server{Handler: httpapi.NewRouter(a.Store, a.Metrics, a.Hub, a.Verifier)}
+	go a.httpServer.Serve(httpLis)
+	a.cfg.HTTPAddr = httpLis.Addr().String()
+
+	return nil
+}
+
+// GRPCAddr returns the bound gRPC listener address, resolved after Start.
+func (a *App) GRPCAddr() string { return a.cfg.GRPCAddr }
+
+// HTTPAddr returns the bound HTTP listener address, resolved after Start.
+func (a *App) HTTPAddr() string { return a.cfg.HTTPAddr }
+
+// AdminAddr returns the bound admin listener address, resolved after Start.
+func (a *App) AdminAddr() string { return a.cfg.AdminAddr }
+
+// Stop gracefully shuts down all listeners and background goroutines.
+func (a *App) Stop(ctx context.Context) {
+	if a.stopRules != nil {
+		a.stopRules()
+	}
+	if a.stopKeys != nil {
+		a.stopKeys()
+	}
+	if a.grpcServer != nil {
+		a.grpcServer.GracefulStop()
+	}
+	if a.httpServer != nil {
+		a.httpServer.Shutdown(ctx)
+	}
+	if a.adminSrv != nil {
+		a.adminSrv.Shutdown(ctx)
+	}
+	if a.Clients != nil {
+		a.Clients.Close()
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"log"
+
+	"order-service/app"
+	"order-service/config"
+)
+
+func main() {
+	a, err := app.New(config.FromEnv())
+	if err != nil {
+		log.Fatalf("order-service: %v", err)
+	}
+	if err := a.Start(); err != nil {
+		log.Fatalf("order-service: %v", err)
+	}
+	log.Printf("order-service: grpc on %s, http on %s, admin on %s", a.GRPCAddr(), a.HTTPAddr(), a.AdminAddr())
+
+	select {}
+}
@@ -0,0 +1,46 @@
+package config
+
+import "os"
+
+// Config holds the runtime configuration for order-service, sourced from
+// the environment so the same binary runs unmodified in dev and prod.
+type Config struct {
+	HTTPAddr  string
+	GRPCAddr  string
+	AdminAddr string
+	DBDSN     string
+
+	MenuServiceAddr string
+	UserServiceAddr string
+	RulesFile       string
+
+	JWTKeyFile string
+	JWTKeyPEM  string
+	JWTJWKSURL string
+}
+
+// FromEnv builds a Config from environment variables, falling back to
+// sensible local-dev defaults for anything unset.
+func FromEnv() Config {
+	return Config{
+		HTTPAddr:  getenv("ORDER_HTTP_ADDR", ":8082"),
+		GRPCAddr:  getenv("ORDER_GRPC_ADDR", ":9082"),
+		AdminAddr: getenv("ORDER_ADMIN_ADDR", ":9102"),
+		DBDSN:     getenv("ORDER_DB_DSN", "order.db"),
+
+		MenuServiceAddr: getenv("ORDER_MENU_SERVICE_ADDR", "localhost:9081"),
+		UserServiceAddr: getenv("ORDER_USER_SERVICE_ADDR", "localhost:9083"),
+		RulesFile:       getenv("ORDER_RULES_FILE", "rules/rules.yaml"),
+
+		JWTKeyFile: getenv("ORDER_JWT_KEY_FILE", ""),
+		JWTKeyPEM:  getenv("ORDER_JWT_KEY_PEM", ""),
+		JWTJWKSURL: getenv("ORDER_JWT_JWKS_URL", ""),
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,47 @@
+// Package clients dials the other student-cafe services order-service
+// needs context from when evaluating acceptance rules.
+package clients
+
+import (
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+	userpb "github.com/douglasswm/student-cafe-protos/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Clients bundles the gRPC clients order-service calls out to.
+type Clients struct {
+	Menu menupb.MenuServiceClient
+	User userpb.UserServiceClient
+
+	menuConn *grpc.ClientConn
+	userConn *grpc.ClientConn
+}
+
+// Dial connects to menu-service at menuAddr and user-service at userAddr.
+func Dial(menuAddr, userAddr string) (*Clients, error) {
+	menuConn, err := grpc.NewClient(menuAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	userConn, err := grpc.NewClient(userAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &Clients{
+		Menu:     menupb.NewMenuServiceClient(menuConn),
+		User:     userpb.NewUserServiceClient(userConn),
+		menuConn: menuConn,
+		userConn: userConn,
+	}, nil
+}
+
+// Close tears down both gRPC connections.
+func (c *Clients) Close() {
+	if c.menuConn != nil {
+		c.menuConn.Close()
+	}
+	if c.userConn != nil {
+		c.userConn.Close()
+	}
+}
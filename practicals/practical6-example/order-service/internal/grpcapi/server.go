@@ -0,0 +1,190 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authn "github.com/douglasswm/cafe-authn"
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+	orderpb "github.com/douglasswm/student-cafe-protos/order"
+	userpb "github.com/douglasswm/student-cafe-protos/user"
+
+	"order-service/internal/clients"
+	"order-service/internal/models"
+	"order-service/internal/store"
+	"order-service/pkg/events"
+	"order-service/pkg/rules"
+)
+
+// HeartbeatInterval is how often WatchOrder sends a keepalive status so
+// proxies and clients can tell the stream is still alive between real
+// status changes. httpapi's WebSocket mirror of WatchOrder pings on the
+// same cadence.
+const HeartbeatInterval = 15 * time.Second
+
+// Server implements orderpb.OrderServiceServer against a Store, evaluating
+// every placed order against the configured rules.Engine before persisting.
+type Server struct {
+	orderpb.UnimplementedOrderServiceServer
+	Store   *store.Store
+	Clients *clients.Clients
+	Rules   *rules.Engine
+	Hub     *events.Hub
+
+	// StoreOpen and Now are overridable for tests. New sets StoreOpen to
+	// true and Now to time.Now; nothing in order-service changes StoreOpen
+	// afterwards.
+	StoreOpen bool
+	Now       func() time.Time
+}
+
+// New constructs a gRPC OrderService server backed by st, c, re, and hub.
+func New(st *store.Store, c *clients.Clients, re *rules.Engine, hub *events.Hub) *Server {
+	return &Server{
+		Store:     st,
+		Clients:   c,
+		Rules:     re,
+		Hub:       hub,
+		StoreOpen: true,
+		Now:       time.Now,
+	}
+}
+
+func (s *Server) PlaceOrder(ctx context.Context, req *orderpb.PlaceOrderRequest) (*orderpb.PlaceOrderResponse, error) {
+	if principal, ok := authn.PrincipalFromContext(ctx); ok && principal.Subject != req.GetUserId() {
+		return nil, status.Error(codes.PermissionDenied, "cannot place an order for another user")
+	}
+
+	user, err := s.Clients.User.GetUser(ctx, &userpb.GetUserRequest{Id: req.GetUserId()})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "lookup user: %v", err)
+	}
+
+	var totalCents int64
+	itemsAvailable := true
+	for _, it := range req.GetItems() {
+		item, err := s.Clients.Menu.GetItem(ctx, &menupb.GetItemRequest{Id: it.GetMenuItemId()})
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "lookup menu item %s: %v", it.GetMenuItemId(), err)
+		}
+		totalCents += item.GetPriceCents() * int64(it.GetQuantity())
+		itemsAvailable = itemsAvailable && item.GetAvailable()
+	}
+
+	dailySpendCents, err := s.Store.DailySpendCents(req.GetUserId(), s.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "look up daily spend: %v", err)
+	}
+
+	allowed, reason, err := s.Rules.Evaluate(ctx, rules.Input{
+		OrderUserID:     req.GetUserId(),
+		OrderTotalCents: totalCents,
+		OrderItemCount:  len(req.GetItems()),
+		UserRole:        user.GetRole(),
+		DailySpendCents: dailySpendCents,
+		ItemAvailable:   itemsAvailable,
+		Now:             s.Now(),
+		StoreOpen:       s.StoreOpen,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "evaluate rules: %v", err)
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.FailedPrecondition, "order rejected: %s", reason)
+	}
+
+	order := &models.Order{
+		ID:         uuid.NewString(),
+		UserID:     req.GetUserId(),
+		Status:     models.StatusPending,
+		TotalCents: totalCents,
+	}
+	for _, it := range req.GetItems() {
+		order.Items = append(order.Items, models.OrderItem{
+			MenuItemID: it.GetMenuItemId(),
+			Quantity:   it.GetQuantity(),
+		})
+	}
+	if err := s.Store.CreateOrder(order); err != nil {
+		return nil, err
+	}
+	return &orderpb.PlaceOrderResponse{Order: toProto(*order)}, nil
+}
+
+func (s *Server) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.Order, error) {
+	order, err := s.Store.GetOrder(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toProto(*order), nil
+}
+
+// WatchOrder streams the order's current status, then every subsequent
+// change, until the client disconnects. A heartbeat re-sends the last known
+// status on a timer so the stream reads as alive even when nothing changes.
+func (s *Server) WatchOrder(req *orderpb.WatchOrderRequest, stream orderpb.OrderService_WatchOrderServer) error {
+	order, err := s.Store.GetOrder(req.GetOrderId())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "order %s: %v", req.GetOrderId(), err)
+	}
+
+	sub := s.Hub.Subscribe(req.GetOrderId())
+	defer s.Hub.Unsubscribe(sub)
+
+	last := orderStatusProto(order.ID, order.Status, s.Now())
+	if err := stream.Send(last); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(HeartbeatInterval)
+	defer ticker.Stop()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change := <-sub.C:
+			last = &orderpb.OrderStatus{
+				OrderId:         change.OrderID,
+				Status:          change.Status,
+				UpdatedAtUnixMs: change.UpdatedAt.UnixMilli(),
+			}
+			if err := stream.Send(last); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := stream.Send(last); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func orderStatusProto(orderID, status string, at time.Time) *orderpb.OrderStatus {
+	return &orderpb.OrderStatus{
+		OrderId:         orderID,
+		Status:          status,
+		UpdatedAtUnixMs: at.UnixMilli(),
+	}
+}
+
+func toProto(o models.Order) *orderpb.Order {
+	p := &orderpb.Order{
+		Id:         o.ID,
+		UserId:     o.UserID,
+		Status:     o.Status,
+		TotalCents: o.TotalCents,
+	}
+	for _, it := range o.Items {
+		p.Items = append(p.Items, &orderpb.OrderItem{
+			MenuItemId: it.MenuItemID,
+			Quantity:   it.Quantity,
+		})
+	}
+	return p
+}
@@ -0,0 +1,70 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	authn "github.com/douglasswm/cafe-authn"
+	metrics "github.com/douglasswm/cafe-metrics"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"order-service/internal/store"
+	"order-service/pkg/events"
+)
+
+// NewRouter builds the chi router serving order-service's admin HTTP API,
+// including the /ws/orders/{id} real-time status channel backed by hub.
+// GetOrder is a public read here, unlike the gRPC GetOrder, which requires
+// a valid token like every order-service RPC; setStatus is an admin-only
+// mutation and the WS stream requires at least
+// a verified principal, the same bar WatchOrder enforces over gRPC.
+func NewRouter(st *store.Store, reg *metrics.Registry, hub *events.Hub, verifier *authn.Verifier) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Recoverer)
+	r.Use(reg.HTTPMiddleware)
+
+	r.Get("/orders/{id}", getOrder(st))
+
+	r.Group(func(r chi.Router) {
+		r.Use(verifier.HTTPMiddleware)
+		r.With(authn.RequireRole(authn.RoleAdmin)).Patch("/orders/{id}/status", setStatus(st))
+		r.Get("/ws/orders/{id}", watchOrderWS(st, hub))
+	})
+
+	return r
+}
+
+func getOrder(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		order, err := st.GetOrder(chi.URLParam(r, "id"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, order)
+	}
+}
+
+func setStatus(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Status string `json:"status"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := st.UpdateStatus(chi.URLParam(r, "id"), body.Status); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
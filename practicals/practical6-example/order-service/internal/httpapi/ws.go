@@ -0,0 +1,84 @@
+package httpapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+
+	"order-service/internal/grpcapi"
+	"order-service/internal/store"
+	"order-service/pkg/events"
+)
+
+// wsWriteWait bounds how long a single WebSocket write (including pings)
+// may take before the connection is considered dead.
+const wsWriteWait = 10 * time.Second
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// Student-cafe clients are served from a different origin in dev
+	// (Vite/webpack dev servers), so we don't restrict on Origin here.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type orderStatusMessage struct {
+	OrderID   string `json:"order_id"`
+	Status    string `json:"status"`
+	UpdatedAt int64  `json:"updated_at_unix_ms"`
+}
+
+// watchOrderWS upgrades GET /ws/orders/{id} to a WebSocket that mirrors
+// WatchOrder: the order's current status first, then every subsequent
+// change, with a ping on the same cadence as the gRPC heartbeat.
+func watchOrderWS(st *store.Store, hub *events.Hub) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		orderID := chi.URLParam(r, "id")
+
+		order, err := st.GetOrder(orderID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		sub := hub.Subscribe(orderID)
+		defer hub.Unsubscribe(sub)
+
+		if err := writeStatus(conn, orderStatusMessage{OrderID: order.ID, Status: order.Status, UpdatedAt: time.Now().UnixMilli()}); err != nil {
+			return
+		}
+
+		ticker := time.NewTicker(grpcapi.HeartbeatInterval)
+		defer ticker.Stop()
+
+		last := orderStatusMessage{OrderID: order.ID, Status: order.Status}
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case change := <-sub.C:
+				last = orderStatusMessage{OrderID: change.OrderID, Status: change.Status, UpdatedAt: change.UpdatedAt.UnixMilli()}
+				if err := writeStatus(conn, last); err != nil {
+					return
+				}
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+func writeStatus(conn *websocket.Conn, msg orderStatusMessage) error {
+	conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	return conn.WriteJSON(msg)
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Order is the persisted representation of a placed cafe order.
+type Order struct {
+	ID         string `gorm:"primaryKey"`
+	UserID     string
+	Status     string
+	TotalCents int64
+	Items      []OrderItem `gorm:"foreignKey:OrderID"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// OrderItem is one line item within an Order.
+type OrderItem struct {
+	ID         uint `gorm:"primaryKey"`
+	OrderID    string
+	MenuItemID string
+	Quantity   int32
+}
+
+const (
+	StatusPending   = "pending"
+	StatusAccepted  = "accepted"
+	StatusPreparing = "preparing"
+	StatusReady     = "ready"
+	StatusCompleted = "completed"
+	StatusRejected  = "rejected"
+)
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"time"
+
+	metrics "github.com/douglasswm/cafe-metrics"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"order-service/internal/models"
+	"order-service/pkg/events"
+)
+
+// Store wraps the GORM handle used by order-service to reach its database.
+type Store struct {
+	DB *gorm.DB
+}
+
+// Open connects to dsn (a sqlite file path in dev, a postgres DSN in prod),
+// runs the auto-migrations order-service owns, wires reg's GORM callbacks so
+// every call is reflected in db_call_duration_seconds, and — if hub is
+// non-nil — registers an after-update hook that publishes every order
+// status change to it.
+func Open(dsn string, reg *metrics.Registry, hub *events.Hub) (*Store, error) {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&models.Order{}, &models.OrderItem{}); err != nil {
+		return nil, err
+	}
+	if reg != nil {
+		if err := reg.RegisterGORMCallbacks(db); err != nil {
+			return nil, err
+		}
+	}
+	if hub != nil {
+		if err := registerEventsCallback(db, hub); err != nil {
+			return nil, err
+		}
+	}
+	return &Store{DB: db}, nil
+}
+
+func registerEventsCallback(db *gorm.DB, hub *events.Hub) error {
+	return db.Callback().Update().After("gorm:update").Register("events:after_update", func(tx *gorm.DB) {
+		if tx.Error != nil {
+			return
+		}
+		order, ok := tx.Statement.Dest.(*models.Order)
+		if !ok || order.ID == "" {
+			return
+		}
+		hub.Publish(events.StatusChange{
+			OrderID:   order.ID,
+			Status:    order.Status,
+			UpdatedAt: time.Now(),
+		})
+	})
+}
+
+// CreateOrder persists a new order and its line items in one transaction.
+func (s *Store) CreateOrder(o *models.Order) error {
+	return s.DB.Create(o).Error
+}
+
+// GetOrder looks up a single order by ID, including its line items.
+func (s *Store) GetOrder(id string) (*models.Order, error) {
+	var o models.Order
+	if err := s.DB.Preload("Items").First(&o, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// UpdateStatus transitions an order to a new status. It loads and saves the
+// full row (rather than a bare column update) so the after-update hook can
+// read the resulting status off tx.Statement.Dest.
+func (s *Store) UpdateStatus(id, status string) error {
+	var o models.Order
+	if err := s.DB.First(&o, "id = ?", id).Error; err != nil {
+		return err
+	}
+	o.Status = status
+	return s.DB.Save(&o).Error
+}
+
+// DailySpendCents sums the TotalCents of userID's non-rejected orders
+// created on the same calendar day as at (in at's location), for the
+// rules engine's per-user daily spend cap.
+func (s *Store) DailySpendCents(userID string, at time.Time) (int64, error) {
+	dayStart := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+	var total int64
+	err := s.DB.Model(&models.Order{}).
+		Where("user_id = ? AND created_at >= ? AND status != ?", userID, dayStart, models.StatusRejected).
+		Select("COALESCE(SUM(total_cents), 0)").
+		Scan(&total).Error
+	return total, err
+}
@@ -0,0 +1,96 @@
+// Package events is an in-process pub/sub hub that fans order status
+// changes out to any number of subscribers (gRPC streams, WebSocket
+// connections) without letting a slow consumer block the publisher.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// subscriberBuffer bounds how many unread events a subscriber can fall
+// behind by before the hub starts dropping its oldest pending event.
+const subscriberBuffer = 16
+
+// StatusChange is one order status transition.
+type StatusChange struct {
+	OrderID   string
+	Status    string
+	UpdatedAt time.Time
+}
+
+// Subscriber receives StatusChanges for a single order on C. Callers must
+// pass it to Hub.Unsubscribe when done; Unsubscribe stops delivery but
+// does not close C, so a range over C never ends on its own.
+type Subscriber struct {
+	C <-chan StatusChange
+
+	orderID string
+	ch      chan StatusChange
+}
+
+// Hub fans out StatusChanges to subscribers grouped by order ID.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[string]map[*Subscriber]struct{}
+}
+
+// NewHub constructs an empty Hub.
+func NewHub() *Hub {
+	return &Hub{subs: make(map[string]map[*Subscriber]struct{})}
+}
+
+// Subscribe registers interest in orderID's status changes. The returned
+// Subscriber must be passed to Unsubscribe when the caller is done.
+func (h *Hub) Subscribe(orderID string) *Subscriber {
+	ch := make(chan StatusChange, subscriberBuffer)
+	sub := &Subscriber{C: ch, ch: ch, orderID: orderID}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[orderID] == nil {
+		h.subs[orderID] = make(map[*Subscriber]struct{})
+	}
+	h.subs[orderID][sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes sub from its order's fan-out set.
+func (h *Hub) Unsubscribe(sub *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if set, ok := h.subs[sub.orderID]; ok {
+		delete(set, sub)
+		if len(set) == 0 {
+			delete(h.subs, sub.orderID)
+		}
+	}
+}
+
+// Publish delivers change to every subscriber of change.OrderID. A
+// subscriber whose buffer is full has its oldest pending event dropped to
+// make room, so a slow consumer sees gaps rather than stalling the
+// publisher.
+func (h *Hub) Publish(change StatusChange) {
+	h.mu.Lock()
+	subs := make([]*Subscriber, 0, len(h.subs[change.OrderID]))
+	for s := range h.subs[change.OrderID] {
+		subs = append(subs, s)
+	}
+	h.mu.Unlock()
+
+	for _, s := range subs {
+		select {
+		case s.ch <- change:
+		default:
+			select {
+			case <-s.ch:
+			default:
+			}
+			select {
+			case s.ch <- change:
+			default:
+			}
+		}
+	}
+}
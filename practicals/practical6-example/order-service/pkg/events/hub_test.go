@@ -0,0 +1,66 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubDeliversToSubscriber(t *testing.T) {
+	h := NewHub()
+	sub := h.Subscribe("order-1")
+	defer h.Unsubscribe(sub)
+
+	h.Publish(StatusChange{OrderID: "order-1", Status: "accepted", UpdatedAt: time.Now()})
+
+	select {
+	case change := <-sub.C:
+		require.Equal(t, "accepted", change.Status)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestHubIgnoresOtherOrders(t *testing.T) {
+	h := NewHub()
+	sub := h.Subscribe("order-1")
+	defer h.Unsubscribe(sub)
+
+	h.Publish(StatusChange{OrderID: "order-2", Status: "accepted"})
+
+	select {
+	case change := <-sub.C:
+		t.Fatalf("unexpected event for unrelated order: %+v", change)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHubDropsOldestWhenSubscriberIsSlow(t *testing.T) {
+	h := NewHub()
+	sub := h.Subscribe("order-1")
+	defer h.Unsubscribe(sub)
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		h.Publish(StatusChange{OrderID: "order-1", Status: string(rune('a' + i))})
+	}
+
+	// The buffer should contain only the most recent subscriberBuffer events;
+	// the oldest ones were dropped rather than blocking Publish.
+	first := <-sub.C
+	require.NotEqual(t, "a", first.Status, "oldest events should have been dropped")
+}
+
+func TestHubUnsubscribeStopsDelivery(t *testing.T) {
+	h := NewHub()
+	sub := h.Subscribe("order-1")
+	h.Unsubscribe(sub)
+
+	h.Publish(StatusChange{OrderID: "order-1", Status: "accepted"})
+
+	select {
+	case change := <-sub.C:
+		t.Fatalf("unexpected event after unsubscribe: %+v", change)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
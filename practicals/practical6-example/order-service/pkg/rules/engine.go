@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ruleFile is the on-disk YAML shape for a rule file: an ordered list of
+// named CEL policies, evaluated in file order.
+type ruleFile struct {
+	Rules []struct {
+		Name string `yaml:"name"`
+		Expr string `yaml:"expr"`
+	} `yaml:"rules"`
+}
+
+type namedProgram struct {
+	name string
+	prg  *Program
+}
+
+// Engine holds the live set of order-acceptance rules loaded from a file,
+// and can be hot-reloaded (e.g. on SIGHUP) without dropping in-flight
+// evaluations.
+type Engine struct {
+	path string
+
+	mu    sync.RWMutex
+	rules []namedProgram
+
+	stop chan struct{}
+}
+
+// LoadEngine reads and compiles the rule file at path.
+func LoadEngine(path string) (*Engine, error) {
+	e := &Engine{path: path}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Reload re-reads and recompiles the rule file, swapping it in atomically
+// only if every rule compiles cleanly. A bad file leaves the previous,
+// working rule set in place.
+func (e *Engine) Reload() error {
+	raw, err := os.ReadFile(e.path)
+	if err != nil {
+		return fmt.Errorf("rules: read %s: %w", e.path, err)
+	}
+
+	var rf ruleFile
+	if err := yaml.Unmarshal(raw, &rf); err != nil {
+		return fmt.Errorf("rules: parse %s: %w", e.path, err)
+	}
+
+	compiled := make([]namedProgram, 0, len(rf.Rules))
+	for _, r := range rf.Rules {
+		prg, err := Compile(r.Expr)
+		if err != nil {
+			return fmt.Errorf("rules: rule %q: %w", r.Name, err)
+		}
+		compiled = append(compiled, namedProgram{name: r.Name, prg: prg})
+	}
+
+	e.mu.Lock()
+	e.rules = compiled
+	e.mu.Unlock()
+	return nil
+}
+
+// Evaluate runs every loaded rule against in, in file order, short-circuiting
+// on the first rejection.
+func (e *Engine) Evaluate(ctx context.Context, in Input) (allowed bool, reason string, err error) {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+
+	for _, r := range rules {
+		allowed, reason, err := r.prg.Evaluate(ctx, in)
+		if err != nil {
+			return false, "", fmt.Errorf("rules: rule %q: %w", r.name, err)
+		}
+		if !allowed {
+			return false, reason, nil
+		}
+	}
+	return true, "", nil
+}
+
+// WatchSIGHUP reloads the rule file whenever the process receives SIGHUP,
+// logging (but not panicking on) reload failures so a typo in the rule
+// file can't take order-service down. Call the returned func to stop
+// watching.
+func (e *Engine) WatchSIGHUP() func() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+				if err := e.Reload(); err != nil {
+					log.Printf("rules: reload %s failed, keeping previous rules: %v", e.path, err)
+				} else {
+					log.Printf("rules: reloaded %s", e.path)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigCh)
+		close(done)
+	}
+}
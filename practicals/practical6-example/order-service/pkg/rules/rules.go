@@ -0,0 +1,117 @@
+// Package rules lets operators express order-acceptance policy in Google
+// CEL instead of hardcoding it in Go. A compiled Program evaluates a CEL
+// expression against the order under consideration plus the contextual
+// variables listed below, and yields either an empty string (accept) or a
+// non-empty rejection reason.
+//
+// Expressions see:
+//
+//	order.user_id, order.total_cents, order.item_count
+//	user.role, user.daily_spend_cents
+//	menu.item.available
+//	now
+//	store.open
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Input bundles the order and the contextual variables a Program evaluates
+// against.
+type Input struct {
+	OrderUserID     string
+	OrderTotalCents int64
+	OrderItemCount  int
+
+	UserRole        string
+	DailySpendCents int64
+
+	ItemAvailable bool
+
+	Now time.Time
+
+	StoreOpen bool
+}
+
+// Program is a compiled CEL policy expression, safe for concurrent use by
+// multiple goroutines.
+type Program struct {
+	src string
+	prg cel.Program
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("order", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("menu", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("now", cel.TimestampType),
+		cel.Variable("store", cel.MapType(cel.StringType, cel.DynType)),
+	)
+}
+
+// Compile parses and type-checks a CEL source expression. The expression
+// must evaluate to a string: empty means the order is accepted, anything
+// else is returned as the rejection reason.
+func Compile(src string) (*Program, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, fmt.Errorf("rules: build env: %w", err)
+	}
+
+	ast, iss := env.Compile(src)
+	if iss != nil && iss.Err() != nil {
+		return nil, fmt.Errorf("rules: compile %q: %w", src, iss.Err())
+	}
+	if ast.OutputType() != cel.StringType {
+		return nil, fmt.Errorf("rules: compile %q: expression must evaluate to string, got %s", src, ast.OutputType())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("rules: plan %q: %w", src, err)
+	}
+
+	return &Program{src: src, prg: prg}, nil
+}
+
+// Evaluate runs the compiled policy against in and reports whether the
+// order is allowed. A non-nil err indicates a runtime evaluation failure
+// (e.g. a type mismatch CEL couldn't catch at compile time) and should be
+// treated as a rejection by callers, not a crash.
+func (p *Program) Evaluate(ctx context.Context, in Input) (allowed bool, reason string, err error) {
+	vars := map[string]interface{}{
+		"order": map[string]interface{}{
+			"user_id":     in.OrderUserID,
+			"total_cents": in.OrderTotalCents,
+			"item_count":  in.OrderItemCount,
+		},
+		"user": map[string]interface{}{
+			"role":              in.UserRole,
+			"daily_spend_cents": in.DailySpendCents,
+		},
+		"menu": map[string]interface{}{
+			"item": map[string]interface{}{
+				"available": in.ItemAvailable,
+			},
+		},
+		"now":   in.Now,
+		"store": map[string]interface{}{"open": in.StoreOpen},
+	}
+
+	out, _, evalErr := p.prg.ContextEval(ctx, vars)
+	if evalErr != nil {
+		return false, "", fmt.Errorf("rules: evaluate %q: %w", p.src, evalErr)
+	}
+
+	reason, ok := out.Value().(string)
+	if !ok {
+		return false, "", fmt.Errorf("rules: evaluate %q: expected string result, got %T", p.src, out.Value())
+	}
+	return reason == "", reason, nil
+}
@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileRejectsSyntaxErrors(t *testing.T) {
+	_, err := Compile("order.total_cents >")
+	require.Error(t, err)
+}
+
+func TestCompileRejectsNonStringOutput(t *testing.T) {
+	_, err := Compile("order.total_cents > 0")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must evaluate to string")
+}
+
+func TestEvaluateAllowsWhenEmptyStringReturned(t *testing.T) {
+	prg, err := Compile(`""`)
+	require.NoError(t, err)
+
+	allowed, reason, err := prg.Evaluate(context.Background(), Input{})
+	require.NoError(t, err)
+	require.True(t, allowed)
+	require.Empty(t, reason)
+}
+
+func TestEvaluateRejectsWithReason(t *testing.T) {
+	prg, err := Compile(`!store.open ? "closed" : ""`)
+	require.NoError(t, err)
+
+	allowed, reason, err := prg.Evaluate(context.Background(), Input{StoreOpen: false})
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, "closed", reason)
+}
+
+func TestEvaluateCurfewUsesNow(t *testing.T) {
+	prg, err := Compile(`now.getHours() >= 22 ? "curfew" : ""`)
+	require.NoError(t, err)
+
+	night := time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)
+	allowed, reason, err := prg.Evaluate(context.Background(), Input{Now: night})
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, "curfew", reason)
+
+	day := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	allowed, _, err = prg.Evaluate(context.Background(), Input{Now: day})
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestEvaluateRuntimeTypeMismatch(t *testing.T) {
+	// order.total_cents is an int64; concatenating it with a string is a
+	// type error CEL can only catch at runtime because the map is dyn.
+	prg, err := Compile(`order.total_cents + "oops"`)
+	require.NoError(t, err)
+
+	_, _, err = prg.Evaluate(context.Background(), Input{OrderTotalCents: 100})
+	require.Error(t, err)
+}
+
+func TestEngineEvaluatesRulesInOrder(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - name: store-open
+    expr: '!store.open ? "closed" : ""'
+  - name: stock
+    expr: '!menu.item.available ? "out of stock" : ""'
+`), 0o644))
+
+	eng, err := LoadEngine(path)
+	require.NoError(t, err)
+
+	allowed, reason, err := eng.Evaluate(context.Background(), Input{StoreOpen: false, ItemAvailable: true})
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, "closed", reason)
+
+	allowed, reason, err = eng.Evaluate(context.Background(), Input{StoreOpen: true, ItemAvailable: false})
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, "out of stock", reason)
+
+	allowed, _, err = eng.Evaluate(context.Background(), Input{StoreOpen: true, ItemAvailable: true})
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestEngineReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - name: always-allow
+    expr: '""'
+`), 0o644))
+
+	eng, err := LoadEngine(path)
+	require.NoError(t, err)
+
+	allowed, _, err := eng.Evaluate(context.Background(), Input{})
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - name: always-reject
+    expr: '"nope"'
+`), 0o644))
+	require.NoError(t, eng.Reload())
+
+	allowed, reason, err := eng.Evaluate(context.Background(), Input{})
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, "nope", reason)
+}
+
+func TestEngineReloadKeepsPreviousRulesOnBadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - name: always-allow
+    expr: '""'
+`), 0o644))
+
+	eng, err := LoadEngine(path)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  - name: broken
+    expr: 'order.total_cents >'
+`), 0o644))
+	require.Error(t, eng.Reload())
+
+	allowed, _, err := eng.Evaluate(context.Background(), Input{})
+	require.NoError(t, err)
+	require.True(t, allowed, "reload failure must not drop the previously working rules")
+}
@@ -0,0 +1,34 @@
+// Package authn provides the JWT issuance and verification machinery
+// shared by user-service (the issuer) and menu-service/order-service (the
+// consumers), so all three agree on one token format and one set of role
+// names without depending on each other's internal packages.
+package authn
+
+import "github.com/golang-jwt/jwt/v5"
+
+// Role values carried in a token's claims. Services that need to gate an
+// RPC or route by role compare against these rather than inventing their
+// own string literals.
+const (
+	RoleStudent = "student"
+	RoleStaff   = "staff"
+	RoleAdmin   = "admin"
+)
+
+// Claims is the JWT payload student-cafe services issue and verify: the
+// standard registered claims (sub, exp, iat) plus role and token_version,
+// the latter letting Logout invalidate outstanding tokens without a
+// blocklist.
+type Claims struct {
+	jwt.RegisteredClaims
+	Role         string `json:"role"`
+	TokenVersion int64  `json:"token_version"`
+}
+
+// Principal is the authenticated identity attached to a request's context
+// once its token has been verified.
+type Principal struct {
+	Subject      string
+	Role         string
+	TokenVersion int64
+}
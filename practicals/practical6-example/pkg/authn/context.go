@@ -0,0 +1,18 @@
+package authn
+
+import "context"
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying p, retrievable with
+// PrincipalFromContext.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the Principal attached by HTTPMiddleware or
+// a gRPC interceptor, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
@@ -0,0 +1,97 @@
+package authn
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const metadataAuthorizationKey = "authorization"
+
+// FromIncomingContext extracts and verifies the bearer token carried in
+// ctx's incoming gRPC metadata, independent of the interceptor chain.
+// UnaryServerInterceptor and StreamServerInterceptor use it internally;
+// handlers that need to authenticate only one RPC of an otherwise public
+// service (e.g. user-service's Login staying open while Logout requires
+// the caller's own token) call it directly.
+func (v *Verifier) FromIncomingContext(ctx context.Context) (Principal, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Principal{}, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(metadataAuthorizationKey)
+	if len(values) == 0 {
+		return Principal{}, status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+	token, ok := bearerToken(values[0])
+	if !ok {
+		return Principal{}, status.Error(codes.Unauthenticated, "malformed authorization metadata")
+	}
+	principal, err := v.Verify(token)
+	if err != nil {
+		return Principal{}, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	return principal, nil
+}
+
+// UnaryServerInterceptor rejects any call lacking a valid bearer token and
+// injects the resulting Principal into the handler's context otherwise.
+func (v *Verifier) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		principal, err := v.FromIncomingContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(WithPrincipal(ctx, principal), req)
+	}
+}
+
+// StreamServerInterceptor does the same for streaming RPCs, wrapping ss so
+// handlers see the authenticated context via ss.Context().
+func (v *Verifier) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		principal, err := v.FromIncomingContext(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: WithPrincipal(ss.Context(), principal)})
+	}
+}
+
+// OptionalUnaryServerInterceptor behaves like UnaryServerInterceptor, but
+// lets requests through uncontested when no (or an invalid) bearer token
+// is present — only handlers that explicitly call Require or check
+// PrincipalFromContext enforce authentication. Use this on services whose
+// RPCs are a mix of public and role-gated, e.g. menu-service's public
+// catalog reads next to its admin-only mutations.
+func (v *Verifier) OptionalUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if principal, err := v.FromIncomingContext(ctx); err == nil {
+			ctx = WithPrincipal(ctx, principal)
+		}
+		return handler(ctx, req)
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context { return s.ctx }
+
+// RequireRoleUnary returns a grpc.UnaryServerInterceptor that rejects any
+// call whose Principal doesn't hold role, with codes.PermissionDenied.
+// Chain it after UnaryServerInterceptor/OptionalUnaryServerInterceptor so
+// the Principal is already in ctx.
+func RequireRoleUnary(role string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := Require(ctx, role); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "role %q required", role)
+		}
+		return handler(ctx, req)
+	}
+}
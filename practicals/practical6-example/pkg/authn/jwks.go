@@ -0,0 +1,176 @@
+package authn
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWKSKeyProvider fetches RSA and ECDSA public keys from a JWKS endpoint
+// and refreshes them periodically (via Watch), so key rotation on the
+// identity-provider side doesn't require a restart here.
+type JWKSKeyProvider struct {
+	url    string
+	client *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]crypto.PublicKey
+}
+
+// NewJWKSKeyProvider fetches and parses the JWKS document at url.
+func NewJWKSKeyProvider(url string) (*JWKSKeyProvider, error) {
+	p := &JWKSKeyProvider{url: url, client: http.DefaultClient}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type jwksDoc struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	} `json:"keys"`
+}
+
+// Reload re-fetches the JWKS document, swapping the cached key set in
+// atomically only if it parses cleanly.
+func (p *JWKSKeyProvider) Reload() error {
+	resp, err := p.client.Get(p.url)
+	if err != nil {
+		return fmt.Errorf("authn: fetch JWKS %s: %w", p.url, err)
+	}
+	defer resp.Body.Close()
+
+	var doc jwksDoc
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("authn: decode JWKS %s: %w", p.url, err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		var (
+			pub crypto.PublicKey
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			pub, err = rsaPublicKeyFromJWK(k.N, k.E)
+		case "EC":
+			pub, err = ecdsaPublicKeyFromJWK(k.Crv, k.X, k.Y)
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("authn: parse JWKS key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = pub
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	return nil
+}
+
+// PublicKey returns the key matching kid, from the last successful fetch.
+func (p *JWKSKeyProvider) PublicKey(kid string) (crypto.PublicKey, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("authn: no JWKS key for kid %q", kid)
+	}
+	return key, nil
+}
+
+// Watch refreshes the JWKS document every interval until ctx is
+// cancelled, logging (but not failing) on refresh errors so a transient
+// outage at the identity provider doesn't invalidate already-cached keys.
+// Call the returned func to stop watching.
+func (p *JWKSKeyProvider) Watch(ctx context.Context, interval time.Duration) func() {
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := p.Reload(); err != nil {
+					log.Printf("authn: JWKS refresh from %s failed, keeping previous keys: %v", p.url, err)
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func rsaPublicKeyFromJWK(nStr, eStr string) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(nStr)
+	if err != nil {
+		return nil, err
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(eStr)
+	if err != nil {
+		return nil, err
+	}
+	e := 0
+	for _, b := range eBytes {
+		e = e<<8 | int(b)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nBytes), E: e}, nil
+}
+
+func ecdsaPublicKeyFromJWK(crv, xStr, yStr string) (*ecdsa.PublicKey, error) {
+	curve, err := curveForJWKCrv(crv)
+	if err != nil {
+		return nil, err
+	}
+	xBytes, err := base64.RawURLEncoding.DecodeString(xStr)
+	if err != nil {
+		return nil, err
+	}
+	yBytes, err := base64.RawURLEncoding.DecodeString(yStr)
+	if err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(xBytes),
+		Y:     new(big.Int).SetBytes(yBytes),
+	}, nil
+}
+
+func curveForJWKCrv(crv string) (elliptic.Curve, error) {
+	switch crv {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("authn: unsupported EC curve %q", crv)
+	}
+}
@@ -0,0 +1,260 @@
+package authn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// signingKeyBits is the size of RSA key pairs authn generates itself (only
+// ever for the local-development fallback in LoadSigningKey).
+const signingKeyBits = 2048
+
+// KeyProvider returns the public key that verifies a token's signature,
+// either an *rsa.PublicKey (RS256) or an *ecdsa.PublicKey (ES256). kid is
+// the token's header "kid", if any; implementations backed by a single
+// fixed key ignore it.
+type KeyProvider interface {
+	PublicKey(kid string) (crypto.PublicKey, error)
+}
+
+// Reloadable is implemented by KeyProviders whose key material can be
+// refreshed without a restart.
+type Reloadable interface {
+	Reload() error
+}
+
+// KeyConfig describes where a service's verifying KeyProvider gets its
+// public key material from: a PEM file on disk, an inline PEM (e.g. read
+// from an environment variable), or a JWKS URL. When several are set,
+// NewKeyProvider uses JWKSURL first, then KeyFile, then KeyPEM. The key
+// itself may be RSA or ECDSA.
+type KeyConfig struct {
+	KeyFile string
+	KeyPEM  string
+	JWKSURL string
+}
+
+// NewKeyProvider builds the KeyProvider described by cfg.
+func NewKeyProvider(cfg KeyConfig) (KeyProvider, error) {
+	switch {
+	case cfg.JWKSURL != "":
+		return NewJWKSKeyProvider(cfg.JWKSURL)
+	case cfg.KeyFile != "":
+		return NewFileKeyProvider(cfg.KeyFile)
+	case cfg.KeyPEM != "":
+		return NewStaticKeyProviderFromPEM([]byte(cfg.KeyPEM))
+	default:
+		return nil, fmt.Errorf("authn: no key source configured (need a key file, inline PEM, or JWKS URL)")
+	}
+}
+
+// StaticKeyProvider serves a single, fixed public key. It's used when the
+// verifying side already holds the key material in memory — e.g.
+// user-service verifying its own tokens from the private key it signs
+// with — rather than loading it from a file or URL.
+type StaticKeyProvider struct {
+	key crypto.PublicKey
+}
+
+// NewStaticKeyProvider wraps an already-parsed RSA or ECDSA public key.
+func NewStaticKeyProvider(key crypto.PublicKey) *StaticKeyProvider {
+	return &StaticKeyProvider{key: key}
+}
+
+// NewStaticKeyProviderFromPEM parses a single PEM-encoded RSA or ECDSA
+// public key and serves it as a StaticKeyProvider.
+func NewStaticKeyProviderFromPEM(pemBytes []byte) (*StaticKeyProvider, error) {
+	key, err := ParsePublicKeyPEM(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaticKeyProvider(key), nil
+}
+
+func (p *StaticKeyProvider) PublicKey(kid string) (crypto.PublicKey, error) {
+	return p.key, nil
+}
+
+// FileKeyProvider loads an RSA or ECDSA public key in PEM format from a
+// file, and can be hot-reloaded (e.g. via WatchSIGHUP) to pick up a
+// rotated key without a restart.
+type FileKeyProvider struct {
+	path string
+
+	mu  sync.RWMutex
+	key crypto.PublicKey
+}
+
+// NewFileKeyProvider reads and parses the public key at path.
+func NewFileKeyProvider(path string) (*FileKeyProvider, error) {
+	p := &FileKeyProvider{path: path}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload re-reads and re-parses the key file, swapping it in atomically
+// only if it parses cleanly. A bad file leaves the previous, working key
+// in place.
+func (p *FileKeyProvider) Reload() error {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("authn: read key file %s: %w", p.path, err)
+	}
+	key, err := ParsePublicKeyPEM(data)
+	if err != nil {
+		return fmt.Errorf("authn: parse key file %s: %w", p.path, err)
+	}
+	p.mu.Lock()
+	p.key = key
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *FileKeyProvider) PublicKey(kid string) (crypto.PublicKey, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.key == nil {
+		return nil, fmt.Errorf("authn: no key loaded from %s", p.path)
+	}
+	return p.key, nil
+}
+
+// WatchSIGHUP reloads r whenever the process receives SIGHUP, logging
+// (but not failing) on reload errors so a bad key swap can't take a
+// service down. Call the returned func to stop watching.
+func WatchSIGHUP(r Reloadable, label string) func() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+				if err := r.Reload(); err != nil {
+					log.Printf("authn: reload %s failed, keeping previous key: %v", label, err)
+				} else {
+					log.Printf("authn: reloaded %s", label)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigCh)
+		close(done)
+	}
+}
+
+// ParsePublicKeyPEM parses a PEM-encoded RSA or ECDSA public key, accepting
+// both PKIX ("PUBLIC KEY", covering either key type) and PKCS1 ("RSA
+// PUBLIC KEY") blocks.
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("authn: no PEM block found")
+	}
+	if pub, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		switch pub.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			return pub, nil
+		default:
+			return nil, fmt.Errorf("authn: unsupported public key type %T", pub)
+		}
+	}
+	return x509.ParsePKCS1PublicKey(block.Bytes)
+}
+
+// ParseRSAPublicKeyPEM parses a PEM-encoded RSA public key, accepting both
+// PKIX ("PUBLIC KEY") and PKCS1 ("RSA PUBLIC KEY") blocks.
+func ParseRSAPublicKeyPEM(data []byte) (*rsa.PublicKey, error) {
+	key, err := ParsePublicKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("authn: key is not RSA")
+	}
+	return rsaKey, nil
+}
+
+// ParseRSAPrivateKeyPEM parses a PEM-encoded PKCS1 or PKCS8 RSA private
+// key, used by the issuer to sign tokens.
+func ParseRSAPrivateKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("authn: no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("authn: key is not RSA")
+	}
+	return rsaKey, nil
+}
+
+// ParseECDSAPrivateKeyPEM parses a PEM-encoded SEC1 ("EC PRIVATE KEY") or
+// PKCS8 ECDSA private key, used by the issuer to sign ES256 tokens.
+func ParseECDSAPrivateKeyPEM(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("authn: no PEM block found")
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("authn: key is not ECDSA")
+	}
+	return ecKey, nil
+}
+
+// LoadSigningKey reads an RSA or ECDSA private key PEM from path, trying
+// RSA first and falling back to ECDSA. An empty path generates an
+// ephemeral RSA key instead, which is convenient for local development
+// but means every restart invalidates outstanding tokens — production
+// deployments must set a real path.
+func LoadSigningKey(path string) (crypto.Signer, error) {
+	if path == "" {
+		log.Printf("authn: no signing key file configured, generating an ephemeral RSA key (tokens won't survive a restart)")
+		return rsa.GenerateKey(rand.Reader, signingKeyBits)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("authn: read signing key %s: %w", path, err)
+	}
+	if key, err := ParseRSAPrivateKeyPEM(data); err == nil {
+		return key, nil
+	}
+	key, err := ParseECDSAPrivateKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("authn: parse signing key %s as RSA or ECDSA: %w", path, err)
+	}
+	return key, nil
+}
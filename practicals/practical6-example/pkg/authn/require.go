@@ -0,0 +1,24 @@
+package authn
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrForbidden is returned by Require when ctx's Principal doesn't hold
+// the required role (including when ctx carries no Principal at all).
+var ErrForbidden = errors.New("authn: role not permitted")
+
+// Require returns nil if ctx carries a Principal with exactly role, and
+// ErrForbidden otherwise. Handlers that need a per-RPC or per-route role
+// check — where gating the whole service by role would be too coarse,
+// e.g. menu-service's admin-only catalog mutations next to its public
+// reads — call this directly instead of chaining RequireRole/
+// RequireRoleUnary across the whole server.
+func Require(ctx context.Context, role string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.Role != role {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package authn
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenVersionChecker reports the current valid token_version for a
+// subject, so Verify can reject tokens issued before the subject's most
+// recent Logout.
+type TokenVersionChecker interface {
+	CurrentTokenVersion(subject string) (int64, error)
+}
+
+// Verifier validates access tokens against a KeyProvider and, if set, a
+// TokenVersionChecker.
+type Verifier struct {
+	Keys     KeyProvider
+	Versions TokenVersionChecker
+}
+
+// NewVerifier builds a Verifier backed by keys. versions may be nil to
+// skip the revocation check (e.g. in services with no access to the
+// user-service database).
+func NewVerifier(keys KeyProvider, versions TokenVersionChecker) *Verifier {
+	return &Verifier{Keys: keys, Versions: versions}
+}
+
+// Verify parses and validates tokenString, returning the resulting
+// Principal.
+func (v *Verifier) Verify(tokenString string) (Principal, error) {
+	var claims Claims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.Keys.PublicKey(kid)
+	}, jwt.WithValidMethods([]string{"RS256", "ES256"}))
+	if err != nil {
+		return Principal{}, fmt.Errorf("authn: invalid token: %w", err)
+	}
+	if !token.Valid {
+		return Principal{}, fmt.Errorf("authn: invalid token")
+	}
+
+	if v.Versions != nil {
+		current, err := v.Versions.CurrentTokenVersion(claims.Subject)
+		if err != nil {
+			return Principal{}, fmt.Errorf("authn: lookup token version for %s: %w", claims.Subject, err)
+		}
+		if claims.TokenVersion != current {
+			return Principal{}, fmt.Errorf("authn: token revoked")
+		}
+	}
+
+	return Principal{Subject: claims.Subject, Role: claims.Role, TokenVersion: claims.TokenVersion}, nil
+}
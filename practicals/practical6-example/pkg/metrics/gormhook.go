@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const startTimeKey = "metrics:start_time"
+
+// RegisterGORMCallbacks installs before/after hooks on db for create, query,
+// update, delete, row, and raw, recording db_call_duration_seconds by table
+// and op.
+func (r *Registry) RegisterGORMCallbacks(db *gorm.DB) error {
+	ops := []string{"create", "query", "update", "delete", "row", "raw"}
+	for _, op := range ops {
+		if err := registerOp(db, r, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func registerOp(db *gorm.DB, r *Registry, op string) error {
+	before := func(tx *gorm.DB) {
+		tx.Set(startTimeKey, time.Now())
+	}
+	after := func(tx *gorm.DB) {
+		v, ok := tx.Get(startTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+		table := tx.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		r.DBCallDuration.WithLabelValues(table, op).Observe(time.Since(start).Seconds())
+	}
+
+	cb := db.Callback()
+	switch op {
+	case "create":
+		if err := cb.Create().Before("gorm:create").Register("metrics:before_create", before); err != nil {
+			return err
+		}
+		return cb.Create().After("gorm:create").Register("metrics:after_create", after)
+	case "query":
+		if err := cb.Query().Before("gorm:query").Register("metrics:before_query", before); err != nil {
+			return err
+		}
+		return cb.Query().After("gorm:query").Register("metrics:after_query", after)
+	case "update":
+		if err := cb.Update().Before("gorm:update").Register("metrics:before_update", before); err != nil {
+			return err
+		}
+		return cb.Update().After("gorm:update").Register("metrics:after_update", after)
+	case "delete":
+		if err := cb.Delete().Before("gorm:delete").Register("metrics:before_delete", before); err != nil {
+			return err
+		}
+		return cb.Delete().After("gorm:delete").Register("metrics:after_delete", after)
+	case "row":
+		if err := cb.Row().Before("gorm:row").Register("metrics:before_row", before); err != nil {
+			return err
+		}
+		return cb.Row().After("gorm:row").Register("metrics:after_row", after)
+	case "raw":
+		if err := cb.Raw().Before("gorm:raw").Register("metrics:before_raw", before); err != nil {
+			return err
+		}
+		return cb.Raw().After("gorm:raw").Register("metrics:after_raw", after)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor records call count and latency for unary gRPC
+// methods, labeled by full method name and resulting status code.
+func (r *Registry) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		r.observeGRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records call count and latency for streaming gRPC
+// methods, labeled by full method name and resulting status code.
+func (r *Registry) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		r.observeGRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func (r *Registry) observeGRPC(fullMethod string, start time.Time, err error) {
+	code := status.Code(err)
+	if err == nil {
+		code = codes.OK
+	}
+	label := code.String()
+	r.GRPCRequestsTotal.WithLabelValues(fullMethod, label).Inc()
+	r.GRPCRequestDuration.WithLabelValues(fullMethod, label).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+)
+
+// HTTPMiddleware returns chi middleware that records request count and
+// latency series labeled by route pattern, method, and status code.
+func (r *Registry) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		ww := chimw.NewWrapResponseWriter(w, req.ProtoMajor)
+
+		next.ServeHTTP(ww, req)
+
+		route := chi.RouteContext(req.Context()).RoutePattern()
+		if route == "" {
+			route = req.URL.Path
+		}
+		status := strconv.Itoa(ww.Status())
+
+		r.HTTPRequestsTotal.WithLabelValues(route, req.Method, status).Inc()
+		r.HTTPRequestDuration.WithLabelValues(route, req.Method, status).Observe(time.Since(start).Seconds())
+	})
+}
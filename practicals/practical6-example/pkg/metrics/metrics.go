@@ -0,0 +1,88 @@
+// Package metrics provides a shared set of Prometheus RED (rate, errors,
+// duration) instruments for the student cafe services, along with chi
+// middleware, gRPC interceptors, and GORM callbacks that populate them.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry bundles the instruments a service registers once at startup and
+// shares across its HTTP router, gRPC server, and GORM connection.
+type Registry struct {
+	registry *prometheus.Registry
+
+	HTTPRequestsTotal   *prometheus.CounterVec
+	HTTPRequestDuration *prometheus.HistogramVec
+
+	GRPCRequestsTotal   *prometheus.CounterVec
+	GRPCRequestDuration *prometheus.HistogramVec
+
+	DBCallDuration *prometheus.HistogramVec
+}
+
+// NewRegistry creates a fresh Prometheus registry and registers all
+// service-level instruments under it, labeling every series with service.
+func NewRegistry(service string) *Registry {
+	reg := prometheus.NewRegistry()
+
+	constLabels := prometheus.Labels{"service": service}
+
+	r := &Registry{
+		registry: reg,
+		HTTPRequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "http_requests_total",
+			Help:        "Total number of HTTP requests handled, labeled by route, method, and status.",
+			ConstLabels: constLabels,
+		}, []string{"route", "method", "status"}),
+		HTTPRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "http_request_duration_seconds",
+			Help:        "HTTP request latency in seconds, labeled by route, method, and status.",
+			ConstLabels: constLabels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		GRPCRequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "grpc_requests_total",
+			Help:        "Total number of gRPC calls handled, labeled by method and code.",
+			ConstLabels: constLabels,
+		}, []string{"method", "code"}),
+		GRPCRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "grpc_request_duration_seconds",
+			Help:        "gRPC call latency in seconds, labeled by method and code.",
+			ConstLabels: constLabels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		DBCallDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "db_call_duration_seconds",
+			Help:        "GORM call latency in seconds, labeled by table and operation.",
+			ConstLabels: constLabels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{"table", "op"}),
+	}
+
+	reg.MustRegister(
+		r.HTTPRequestsTotal,
+		r.HTTPRequestDuration,
+		r.GRPCRequestsTotal,
+		r.GRPCRequestDuration,
+		r.DBCallDuration,
+	)
+
+	return r
+}
+
+// Handler returns the /metrics HTTP handler to mount on the admin port.
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
+
+// ServeAdmin starts a standalone HTTP server exposing /metrics on addr. It
+// blocks, so callers should invoke it in its own goroutine.
+func (r *Registry) ServeAdmin(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", r.Handler())
+	return http.ListenAndServe(addr, mux)
+}
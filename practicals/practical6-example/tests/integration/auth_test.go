@@ -0,0 +1,222 @@
+package integration
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	authn "github.com/douglasswm/cafe-authn"
+	menuapp "menu-service/app"
+	menuconfig "menu-service/config"
+	orderapp "order-service/app"
+	orderconfig "order-service/config"
+	userapp "user-service/app"
+	userconfig "user-service/config"
+
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+	orderpb "github.com/douglasswm/student-cafe-protos/order"
+	userpb "github.com/douglasswm/student-cafe-protos/user"
+)
+
+type seedUser struct {
+	ID           string `gorm:"primaryKey"`
+	Email        string
+	PasswordHash string
+	Role         string
+	TokenVersion int64
+}
+
+func (seedUser) TableName() string { return "users" }
+
+// authFixture boots user-service, menu-service, and order-service sharing
+// one RSA key pair, the way a real deployment would: user-service signs
+// with the private half, menu-service and order-service verify with the
+// public half.
+type authFixture struct {
+	user  *userapp.App
+	menu  *menuapp.App
+	order *orderapp.App
+
+	signingKey *rsa.PrivateKey
+}
+
+func newAuthFixture(t *testing.T) *authFixture {
+	t.Helper()
+
+	privKey, pubPEM := genTestKeyPair(t)
+	privPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privKey)})
+	keyFile := filepath.Join(t.TempDir(), "signing.pem")
+	require.NoError(t, os.WriteFile(keyFile, privPEM, 0o600))
+
+	userCfg := userconfig.Config{
+		HTTPAddr:   ":0",
+		GRPCAddr:   ":0",
+		AdminAddr:  ":0",
+		DBDSN:      filepath.Join(t.TempDir(), "user.db"),
+		JWTKeyFile: keyFile,
+		JWTKeyID:   "test-kid",
+		TokenTTL:   time.Hour,
+	}
+	userApp, err := userapp.New(userCfg)
+	require.NoError(t, err)
+	require.NoError(t, userApp.Start())
+
+	db, err := gorm.Open(sqlite.Open(userCfg.DBDSN), &gorm.Config{})
+	require.NoError(t, err)
+	for _, u := range []seedUser{
+		{ID: "student-1", Email: "student@cafe.test", Role: "student"},
+		{ID: "admin-1", Email: "admin@cafe.test", Role: "admin"},
+	} {
+		hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+		require.NoError(t, err)
+		u.PasswordHash = string(hash)
+		require.NoError(t, db.Create(&u).Error)
+	}
+
+	menuCfg := menuconfig.Config{
+		HTTPAddr:   ":0",
+		GRPCAddr:   ":0",
+		AdminAddr:  ":0",
+		DBDSN:      filepath.Join(t.TempDir(), "menu.db"),
+		UploadsDir: t.TempDir(),
+		JWTKeyPEM:  pubPEM,
+	}
+	menuApp, err := menuapp.New(menuCfg)
+	require.NoError(t, err)
+	require.NoError(t, menuApp.Start())
+
+	menuDB, err := gorm.Open(sqlite.Open(menuCfg.DBDSN), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, menuDB.Create(&seedMenuItem{
+		ID: "latte", Name: "Latte", PriceCents: 400, Available: true, Stock: 10,
+	}).Error)
+
+	orderCfg := orderconfig.Config{
+		HTTPAddr:        ":0",
+		GRPCAddr:        ":0",
+		AdminAddr:       ":0",
+		DBDSN:           filepath.Join(t.TempDir(), "order.db"),
+		MenuServiceAddr: menuApp.GRPCAddr(),
+		UserServiceAddr: userApp.GRPCAddr(),
+		RulesFile:       filepath.Join("testdata", "rules-no-curfew.yaml"),
+		JWTKeyPEM:       pubPEM,
+	}
+	orderApp, err := orderapp.New(orderCfg)
+	require.NoError(t, err)
+	require.NoError(t, orderApp.Start())
+
+	t.Cleanup(func() {
+		orderApp.Stop(context.Background())
+		menuApp.Stop(context.Background())
+		userApp.Stop(context.Background())
+	})
+
+	return &authFixture{user: userApp, menu: menuApp, order: orderApp, signingKey: privKey}
+}
+
+func (f *authFixture) login(t *testing.T, email string) string {
+	t.Helper()
+	conn, err := grpc.NewClient(f.user.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	resp, err := userpb.NewUserServiceClient(conn).Login(context.Background(), &userpb.LoginRequest{
+		Email:    email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	return resp.GetToken()
+}
+
+func withBearer(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// TestAdminOnlyMenuMutationRejectsWrongRole confirms menu-service's
+// admin-gated UpdateItem accepts an admin's token and rejects a student's.
+func TestAdminOnlyMenuMutationRejectsWrongRole(t *testing.T) {
+	f := newAuthFixture(t)
+	studentToken := f.login(t, "student@cafe.test")
+	adminToken := f.login(t, "admin@cafe.test")
+
+	conn, err := grpc.NewClient(f.menu.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := menupb.NewMenuServiceClient(conn)
+
+	update := &menupb.UpdateItemRequest{Id: "latte", Name: "Latte", PriceCents: 450, Available: true, Stock: 8}
+
+	_, err = client.UpdateItem(withBearer(context.Background(), studentToken), update)
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	item, err := client.UpdateItem(withBearer(context.Background(), adminToken), update)
+	require.NoError(t, err)
+	require.Equal(t, int64(450), item.GetPriceCents())
+}
+
+// TestPlaceOrderAsStudent confirms a student's own token lets them place
+// an order for themselves, and that a call carrying no token at all is
+// rejected with Unauthenticated before order-service looks at the request.
+func TestPlaceOrderAsStudent(t *testing.T) {
+	f := newAuthFixture(t)
+	studentToken := f.login(t, "student@cafe.test")
+
+	conn, err := grpc.NewClient(f.order.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := orderpb.NewOrderServiceClient(conn)
+
+	req := &orderpb.PlaceOrderRequest{
+		UserId: "student-1",
+		Items:  []*orderpb.OrderItem{{MenuItemId: "latte", Quantity: 2}},
+	}
+
+	resp, err := client.PlaceOrder(withBearer(context.Background(), studentToken), req)
+	require.NoError(t, err)
+	require.Equal(t, "student-1", resp.GetOrder().GetUserId())
+	require.Equal(t, int64(800), resp.GetOrder().GetTotalCents())
+
+	_, err = client.PlaceOrder(context.Background(), req)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// TestExpiredTokenRejected confirms order-service's auth interceptor
+// rejects a token whose exp has already passed, distinct from simply
+// having no token at all.
+func TestExpiredTokenRejected(t *testing.T) {
+	f := newAuthFixture(t)
+
+	expired, err := authn.NewIssuer(f.signingKey, "test-kid", -time.Minute)
+	require.NoError(t, err)
+	token, err := expired.Issue("student-1", authn.RoleStudent, 0)
+	require.NoError(t, err)
+
+	conn, err := grpc.NewClient(f.order.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := orderpb.NewOrderServiceClient(conn)
+
+	req := &orderpb.PlaceOrderRequest{
+		UserId: "student-1",
+		Items:  []*orderpb.OrderItem{{MenuItemId: "latte", Quantity: 1}},
+	}
+	_, err = client.PlaceOrder(withBearer(context.Background(), token), req)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
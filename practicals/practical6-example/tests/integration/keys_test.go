@@ -0,0 +1,25 @@
+package integration
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// genTestKeyPair generates a fresh RSA key pair for tests that need to
+// configure a service's JWT verifier without a real key-distribution
+// setup, returning the private key (to mint tokens) and its public half
+// PEM-encoded (to configure the verifying service).
+func genTestKeyPair(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	return key, string(pubPEM)
+}
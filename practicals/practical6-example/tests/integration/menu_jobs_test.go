@@ -0,0 +1,138 @@
+package integration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	authn "github.com/douglasswm/cafe-authn"
+	menuapp "menu-service/app"
+	menuconfig "menu-service/config"
+
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+	menuadminpb "github.com/douglasswm/student-cafe-protos/menuadmin"
+)
+
+// seedMenuItem and seedMenuItemDefaults mirror the columns menu-service's
+// internal models persist, so this test can seed rows without importing
+// menu-service's internal packages.
+type seedMenuItem struct {
+	ID             string `gorm:"primaryKey"`
+	Name           string
+	PriceCents     int64
+	Available      bool
+	Stock          int32
+	AvailableFrom  *time.Time
+	AvailableUntil *time.Time
+	Archived       bool
+	UpdatedAt      time.Time
+}
+
+func (seedMenuItem) TableName() string { return "menu_items" }
+
+type seedMenuItemDefaults struct {
+	MenuItemID   string `gorm:"primaryKey"`
+	DefaultStock int32
+}
+
+func (seedMenuItemDefaults) TableName() string { return "menu_item_defaults" }
+
+// TestMenuLifecycleJobsViaAdminAPI seeds menu-service's database to look
+// like a full day has already passed — an item run down to zero stock, a
+// special due to be published, and an item stale long enough to archive
+// — then force-runs each scheduled job through the MenuAdminService
+// TriggerJob RPC and asserts the catalog reflects the result, fast-
+// forwarding the daily lifecycle without waiting on the real clock.
+func TestMenuLifecycleJobsViaAdminAPI(t *testing.T) {
+	signingKey, pubPEM := genTestKeyPair(t)
+	cfg := menuconfig.Config{
+		HTTPAddr:   ":0",
+		GRPCAddr:   ":0",
+		AdminAddr:  ":0",
+		DBDSN:      filepath.Join(t.TempDir(), "menu.db"),
+		JobsFile:   filepath.Join("..", "..", "menu-service", "jobs", "jobs.yaml"),
+		UploadsDir: t.TempDir(),
+		JWTKeyPEM:  pubPEM,
+	}
+
+	a, err := menuapp.New(cfg)
+	require.NoError(t, err)
+	require.NoError(t, a.Start())
+	defer a.Stop(context.Background())
+
+	db, err := gorm.Open(sqlite.Open(cfg.DBDSN), &gorm.Config{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	past := now.Add(-30 * 24 * time.Hour)
+
+	require.NoError(t, db.Create(&seedMenuItem{
+		ID: "depleted-coffee", Name: "Coffee", PriceCents: 250, Available: true, Stock: 0,
+	}).Error)
+	require.NoError(t, db.Create(&seedMenuItemDefaults{MenuItemID: "depleted-coffee", DefaultStock: 50}).Error)
+
+	specialFrom := now.Add(-time.Hour)
+	require.NoError(t, db.Create(&seedMenuItem{
+		ID: "weekend-special", Name: "Weekend Special", PriceCents: 500, Available: false, Stock: 10,
+		AvailableFrom: &specialFrom,
+	}).Error)
+
+	require.NoError(t, db.Create(&seedMenuItem{
+		ID: "stale-muffin", Name: "Stale Muffin", PriceCents: 150, Available: false, Stock: 0,
+		UpdatedAt: past,
+	}).Error)
+
+	adminConn, err := grpc.NewClient(a.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer adminConn.Close()
+	admin := menuadminpb.NewMenuAdminServiceClient(adminConn)
+	menu := menupb.NewMenuServiceClient(adminConn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	issuer, err := authn.NewIssuer(signingKey, "test-kid", time.Hour)
+	require.NoError(t, err)
+	adminToken, err := issuer.Issue("staff-1", authn.RoleAdmin, 0)
+	require.NoError(t, err)
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+adminToken)
+
+	jobsResp, err := admin.ListJobs(ctx, &menuadminpb.ListJobsRequest{})
+	require.NoError(t, err)
+	require.Len(t, jobsResp.GetJobs(), 3)
+
+	for _, name := range []string{"stock-replenishment", "specials-lifecycle", "archive-stale-items"} {
+		_, err := admin.TriggerJob(ctx, &menuadminpb.TriggerJobRequest{Name: name})
+		require.NoError(t, err)
+
+		run, err := admin.GetLastRun(ctx, &menuadminpb.GetLastRunRequest{Name: name})
+		require.NoError(t, err)
+		require.True(t, run.GetLastSuccess(), "job %s should report success", name)
+	}
+
+	coffee, err := menu.GetItem(ctx, &menupb.GetItemRequest{Id: "depleted-coffee"})
+	require.NoError(t, err)
+	require.Equal(t, int32(50), coffee.GetStock(), "stock-replenishment should reset depleted stock to its default")
+
+	special, err := menu.GetItem(ctx, &menupb.GetItemRequest{Id: "weekend-special"})
+	require.NoError(t, err)
+	require.True(t, special.GetAvailable(), "specials-lifecycle should publish a special whose available_from has arrived")
+
+	listResp, err := menu.ListItems(ctx, &menupb.ListItemsRequest{})
+	require.NoError(t, err)
+	var sawStaleMuffin bool
+	for _, item := range listResp.GetItems() {
+		if item.GetId() == "stale-muffin" {
+			sawStaleMuffin = true
+		}
+	}
+	require.False(t, sawStaleMuffin, "archive-stale-items should hide the archived item from the catalog")
+}
@@ -0,0 +1,63 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	menupb "github.com/douglasswm/student-cafe-protos/menu"
+	menuapp "menu-service/app"
+	menuconfig "menu-service/config"
+)
+
+// TestMenuServiceExposesMetrics drives a ListItems gRPC call through a
+// live menu-service instance and asserts the resulting RED series show up
+// on its /metrics endpoint.
+func TestMenuServiceExposesMetrics(t *testing.T) {
+	_, pubPEM := genTestKeyPair(t)
+	cfg := menuconfig.Config{
+		HTTPAddr:   ":0",
+		GRPCAddr:   ":0",
+		AdminAddr:  ":0",
+		DBDSN:      filepath.Join(t.TempDir(), "menu.db"),
+		UploadsDir: t.TempDir(),
+		JWTKeyPEM:  pubPEM,
+	}
+
+	a, err := menuapp.New(cfg)
+	require.NoError(t, err)
+	require.NoError(t, a.Start())
+	defer a.Stop(context.Background())
+
+	conn, err := grpc.NewClient(a.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := menupb.NewMenuServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = client.ListItems(ctx, &menupb.ListItemsRequest{})
+	require.NoError(t, err)
+
+	resp, err := http.Get("http://" + a.AdminAddr() + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	text := string(body)
+
+	require.Contains(t, text, "grpc_requests_total")
+	require.Contains(t, text, `method="/menu.MenuService/ListItems"`)
+	require.True(t, strings.Contains(text, "db_call_duration_seconds"))
+}
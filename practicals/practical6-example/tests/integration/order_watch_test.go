@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	authn "github.com/douglasswm/cafe-authn"
+	orderapp "order-service/app"
+	orderconfig "order-service/config"
+
+	orderpb "github.com/douglasswm/student-cafe-protos/order"
+)
+
+// seedOrder mirrors just the columns order-service's models.Order persists,
+// so this test can seed a row without importing order-service's internal
+// packages.
+type seedOrder struct {
+	ID         string `gorm:"primaryKey"`
+	UserID     string
+	Status     string
+	TotalCents int64
+}
+
+func (seedOrder) TableName() string { return "orders" }
+
+// TestOrderStatusStreamingGRPCAndWS places an order's row directly, then
+// subscribes to its status over both WatchOrder (gRPC) and /ws/orders/{id}
+// (WebSocket), mutates the status twice through the admin HTTP API, and
+// asserts both channels observe the same sequence of statuses.
+func TestOrderStatusStreamingGRPCAndWS(t *testing.T) {
+	signingKey, pubPEM := genTestKeyPair(t)
+
+	cfg := orderconfig.Config{
+		HTTPAddr:        ":0",
+		GRPCAddr:        ":0",
+		AdminAddr:       ":0",
+		DBDSN:           filepath.Join(t.TempDir(), "order.db"),
+		MenuServiceAddr: "localhost:0",
+		UserServiceAddr: "localhost:0",
+		RulesFile:       filepath.Join("..", "..", "order-service", "rules", "rules.yaml"),
+		JWTKeyPEM:       pubPEM,
+	}
+
+	a, err := orderapp.New(cfg)
+	require.NoError(t, err)
+	require.NoError(t, a.Start())
+	defer a.Stop(context.Background())
+
+	issuer, err := authn.NewIssuer(signingKey, "test-kid", time.Hour)
+	require.NoError(t, err)
+	token, err := issuer.Issue("user-1", authn.RoleStudent, 0)
+	require.NoError(t, err)
+	adminToken, err := issuer.Issue("staff-1", authn.RoleAdmin, 0)
+	require.NoError(t, err)
+
+	db, err := gorm.Open(sqlite.Open(cfg.DBDSN), &gorm.Config{})
+	require.NoError(t, err)
+	order := seedOrder{ID: "order-watch-1", UserID: "user-1", Status: "pending", TotalCents: 1500}
+	require.NoError(t, db.Create(&order).Error)
+
+	conn, err := grpc.NewClient(a.GRPCAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := orderpb.NewOrderServiceClient(conn)
+
+	streamCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	streamCtx = metadata.AppendToOutgoingContext(streamCtx, "authorization", "Bearer "+token)
+	stream, err := client.WatchOrder(streamCtx, &orderpb.WatchOrderRequest{OrderId: order.ID})
+	require.NoError(t, err)
+
+	wsURL := "ws://" + a.HTTPAddr() + "/ws/orders/" + order.ID
+	wsHeader := http.Header{"Authorization": []string{"Bearer " + token}}
+	ws, _, err := websocket.DefaultDialer.Dial(wsURL, wsHeader)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	grpcStatuses := make(chan string, 8)
+	go func() {
+		for {
+			status, err := stream.Recv()
+			if err != nil {
+				close(grpcStatuses)
+				return
+			}
+			grpcStatuses <- status.GetStatus()
+		}
+	}()
+
+	wsStatuses := make(chan string, 8)
+	go func() {
+		for {
+			var msg struct {
+				Status string `json:"status"`
+			}
+			if err := ws.ReadJSON(&msg); err != nil {
+				close(wsStatuses)
+				return
+			}
+			wsStatuses <- msg.Status
+		}
+	}()
+
+	require.Equal(t, "pending", recvStatus(t, grpcStatuses))
+	require.Equal(t, "pending", recvStatus(t, wsStatuses))
+
+	setStatus(t, a.HTTPAddr(), adminToken, order.ID, "accepted")
+	require.Equal(t, "accepted", recvStatus(t, grpcStatuses))
+	require.Equal(t, "accepted", recvStatus(t, wsStatuses))
+
+	setStatus(t, a.HTTPAddr(), adminToken, order.ID, "preparing")
+	require.Equal(t, "preparing", recvStatus(t, grpcStatuses))
+	require.Equal(t, "preparing", recvStatus(t, wsStatuses))
+}
+
+func setStatus(t *testing.T, addr, token, orderID, status string) {
+	t.Helper()
+	body, err := json.Marshal(map[string]string{"status": status})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPatch, "http://"+addr+"/orders/"+orderID+"/status", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func recvStatus(t *testing.T, c chan string) string {
+	t.Helper()
+	select {
+	case s, ok := <-c:
+		require.True(t, ok, "channel closed before expected status arrived")
+		return s
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for status")
+		return ""
+	}
+}
@@ -0,0 +1,116 @@
+// Package app wires user-service's dependencies together so both the
+// production entrypoint (cmd/server) and integration tests can start and
+// stop a fully configured instance in-process.
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	authn "github.com/douglasswm/cafe-authn"
+	metrics "github.com/douglasswm/cafe-metrics"
+	userpb "github.com/douglasswm/student-cafe-protos/user"
+	"google.golang.org/grpc"
+
+	"user-service/config"
+	"user-service/internal/grpcapi"
+	"user-service/internal/httpapi"
+	"user-service/internal/store"
+)
+
+// App is a fully wired user-service instance.
+type App struct {
+	cfg      config.Config
+	Store    *store.Store
+	Metrics  *metrics.Registry
+	Issuer   *authn.Issuer
+	Verifier *authn.Verifier
+
+	grpcServer *grpc.Server
+	httpServer *http.Server
+	adminSrv   *http.Server
+}
+
+// New builds an App from cfg: it opens the store, registers metrics, and
+// loads (or, for local dev, generates) the RSA or ECDSA key pair used to sign and
+// verify access tokens, but binds no listeners yet.
+func New(cfg config.Config) (*App, error) {
+	reg := metrics.NewRegistry("user-service")
+
+	st, err := store.Open(cfg.DBDSN, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := authn.LoadSigningKey(cfg.JWTKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := authn.NewIssuer(key, cfg.JWTKeyID, cfg.TokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	verifier := authn.NewVerifier(authn.NewStaticKeyProvider(key.Public()), st)
+
+	return &App{cfg: cfg, Store: st, Metrics: reg, Issuer: issuer, Verifier: verifier}, nil
+}
+
+// Start binds the gRPC, HTTP, and admin listeners and begins serving, using
+// cfg's configured addresses ("" picks an ephemeral port for tests). It
+// returns once all listeners are bound; serving continues in goroutines.
+func (a *App) Start() error {
+	grpcLis, err := net.Listen("tcp", a.cfg.GRPCAddr)
+	if err != nil {
+		return err
+	}
+	a.grpcServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(a.Metrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(a.Metrics.StreamServerInterceptor()),
+	)
+	userpb.RegisterUserServiceServer(a.grpcServer, grpcapi.New(a.Store, a.Issuer, a.Verifier))
+	go a.grpcServer.Serve(grpcLis)
+	a.cfg.GRPCAddr = grpcLis.Addr().String()
+
+	adminLis, err := net.Listen("tcp", a.cfg.AdminAddr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", a.Metrics.Handler())
+	a.adminSrv = &http.Server{Handler: mux}
+	go a.adminSrv.Serve(adminLis)
+	a.cfg.AdminAddr = adminLis.Addr().String()
+
+	httpLis, err := net.Listen("tcp", a.cfg.HTTPAddr)
+	if err != nil {
+		return err
+	}
+	a.httpServer = &http.Server{Handler: httpapi.NewRouter(a.Store, a.Metrics, a.Verifier)}
+	go a.httpServer.Serve(httpLis)
+	a.cfg.HTTPAddr = httpLis.Addr().String()
+
+	return nil
+}
+
+// GRPCAddr returns the bound gRPC listener address, resolved after Start.
+func (a *App) GRPCAddr() string { return a.cfg.GRPCAddr }
+
+// HTTPAddr returns the bound HTTP listener address, resolved after Start.
+func (a *App) HTTPAddr() string { return a.cfg.HTTPAddr }
+
+// AdminAddr returns the bound admin listener address, resolved after Start.
+func (a *App) AdminAddr() string { return a.cfg.AdminAddr }
+
+// Stop gracefully shuts down all listeners.
+func (a *App) Stop(ctx context.Context) {
+	if a.grpcServer != nil {
+		a.grpcServer.GracefulStop()
+	}
+	if a.httpServer != nil {
+		a.httpServer.Shutdown(ctx)
+	}
+	if a.adminSrv != nil {
+		a.adminSrv.Shutdown(ctx)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"log"
+
+	"user-service/app"
+	"user-service/config"
+)
+
+func main() {
+	a, err := app.New(config.FromEnv())
+	if err != nil {
+		log.Fatalf("user-service: %v", err)
+	}
+	if err := a.Start(); err != nil {
+		log.Fatalf("user-service: %v", err)
+	}
+	log.Printf("user-service: grpc on %s, http on %s, admin on %s", a.GRPCAddr(), a.HTTPAddr(), a.AdminAddr())
+
+	select {}
+}
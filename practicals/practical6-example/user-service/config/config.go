@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// Config holds the runtime configuration for user-service, sourced from
+// the environment so the same binary runs unmodified in dev and prod.
+type Config struct {
+	HTTPAddr  string
+	GRPCAddr  string
+	AdminAddr string
+	DBDSN     string
+
+	// JWTKeyFile is a PEM-encoded RSA or ECDSA private key used both to sign
+	// tokens on Login and, via its public half, to verify them on
+	// Logout. Empty generates an ephemeral key for local development.
+	JWTKeyFile string
+	// JWTKeyID tags issued tokens' "kid" header, so menu-service and
+	// order-service can pick the right key out of a JWKS if they're
+	// ever configured to verify against one instead of a single file.
+	JWTKeyID string
+	TokenTTL time.Duration
+}
+
+// FromEnv builds a Config from environment variables, falling back to
+// sensible local-dev defaults for anything unset.
+func FromEnv() Config {
+	return Config{
+		HTTPAddr:  getenv("USER_HTTP_ADDR", ":8083"),
+		GRPCAddr:  getenv("USER_GRPC_ADDR", ":9083"),
+		AdminAddr: getenv("USER_ADMIN_ADDR", ":9103"),
+		DBDSN:     getenv("USER_DB_DSN", "user.db"),
+
+		JWTKeyFile: getenv("USER_JWT_KEY_FILE", ""),
+		JWTKeyID:   getenv("USER_JWT_KEY_ID", "user-service-1"),
+		TokenTTL:   getDuration("USER_TOKEN_TTL", 15*time.Minute),
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
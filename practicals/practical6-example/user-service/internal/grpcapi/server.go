@@ -0,0 +1,79 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authn "github.com/douglasswm/cafe-authn"
+	userpb "github.com/douglasswm/student-cafe-protos/user"
+
+	"user-service/internal/models"
+	"user-service/internal/store"
+)
+
+// Server implements userpb.UserServiceServer against a Store, issuing and
+// verifying JWTs via authn.
+type Server struct {
+	userpb.UnimplementedUserServiceServer
+	Store    *store.Store
+	Issuer   *authn.Issuer
+	Verifier *authn.Verifier
+}
+
+// New constructs a gRPC UserService server backed by st, signing tokens
+// with issuer and verifying them (for Logout) with verifier.
+func New(st *store.Store, issuer *authn.Issuer, verifier *authn.Verifier) *Server {
+	return &Server{Store: st, Issuer: issuer, Verifier: verifier}
+}
+
+func (s *Server) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
+	u, err := s.Store.GetByEmail(req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.GetPassword())); err != nil {
+		return nil, errors.New("invalid credentials")
+	}
+	token, err := s.Issuer.Issue(u.ID, u.Role, u.TokenVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.LoginResponse{Token: token, User: toProto(*u)}, nil
+}
+
+// Logout invalidates every outstanding token for the caller's own account
+// by bumping its token_version. The caller's identity comes from their
+// bearer token, not req.UserId, so a user can only log themselves out.
+func (s *Server) Logout(ctx context.Context, req *userpb.LogoutRequest) (*userpb.LogoutResponse, error) {
+	principal, err := s.Verifier.FromIncomingContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.Subject != req.GetUserId() {
+		return nil, status.Error(codes.PermissionDenied, "cannot log out another user")
+	}
+	if _, err := s.Store.BumpTokenVersion(principal.Subject); err != nil {
+		return nil, err
+	}
+	return &userpb.LogoutResponse{}, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *userpb.GetUserRequest) (*userpb.User, error) {
+	u, err := s.Store.GetByID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toProto(*u), nil
+}
+
+func toProto(u models.User) *userpb.User {
+	return &userpb.User{
+		Id:    u.ID,
+		Email: u.Email,
+		Role:  u.Role,
+	}
+}
@@ -0,0 +1,39 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	authn "github.com/douglasswm/cafe-authn"
+	metrics "github.com/douglasswm/cafe-metrics"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"user-service/internal/store"
+)
+
+// NewRouter builds the chi router serving user-service's HTTP API. Every
+// route requires a valid bearer token, verified by verifier.
+func NewRouter(st *store.Store, reg *metrics.Registry, verifier *authn.Verifier) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Recoverer)
+	r.Use(reg.HTTPMiddleware)
+	r.Use(verifier.HTTPMiddleware)
+
+	r.Get("/users/{id}", getUser(st))
+
+	return r
+}
+
+func getUser(st *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, err := st.GetByID(chi.URLParam(r, "id"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(u)
+	}
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Role values a User can hold.
+const (
+	RoleStudent = "student"
+	RoleStaff   = "staff"
+	RoleAdmin   = "admin"
+)
+
+// User is the persisted representation of a cafe account.
+type User struct {
+	ID           string `gorm:"primaryKey"`
+	Email        string `gorm:"uniqueIndex"`
+	PasswordHash string
+	Role         string
+
+	// TokenVersion is embedded in every JWT issued for this user. Logout
+	// bumps it, which immediately invalidates any outstanding token whose
+	// embedded version no longer matches.
+	TokenVersion int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
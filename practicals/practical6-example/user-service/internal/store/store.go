@@ -0,0 +1,75 @@
+package store
+
+import (
+	metrics "github.com/douglasswm/cafe-metrics"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"user-service/internal/models"
+)
+
+// Store wraps the GORM handle used by user-service to reach its database.
+type Store struct {
+	DB *gorm.DB
+}
+
+// Open connects to dsn (a sqlite file path in dev, a postgres DSN in prod),
+// runs the auto-migrations user-service owns, and wires reg's GORM callbacks
+// so every call is reflected in db_call_duration_seconds.
+func Open(dsn string, reg *metrics.Registry) (*Store, error) {
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&models.User{}); err != nil {
+		return nil, err
+	}
+	if reg != nil {
+		if err := reg.RegisterGORMCallbacks(db); err != nil {
+			return nil, err
+		}
+	}
+	return &Store{DB: db}, nil
+}
+
+// GetByEmail looks up a user by email.
+func (s *Store) GetByEmail(email string) (*models.User, error) {
+	var u models.User
+	if err := s.DB.First(&u, "email = ?", email).Error; err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// GetByID looks up a user by ID.
+func (s *Store) GetByID(id string) (*models.User, error) {
+	var u models.User
+	if err := s.DB.First(&u, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// BumpTokenVersion increments id's token_version by one, invalidating any
+// token already issued to them, and returns the new version.
+func (s *Store) BumpTokenVersion(id string) (int64, error) {
+	var u models.User
+	if err := s.DB.First(&u, "id = ?", id).Error; err != nil {
+		return 0, err
+	}
+	u.TokenVersion++
+	if err := s.DB.Save(&u).Error; err != nil {
+		return 0, err
+	}
+	return u.TokenVersion, nil
+}
+
+// CurrentTokenVersion implements authn.TokenVersionChecker, so a
+// Verifier can reject tokens issued before subject's most recent Logout.
+func (s *Store) CurrentTokenVersion(subject string) (int64, error) {
+	u, err := s.GetByID(subject)
+	if err != nil {
+		return 0, err
+	}
+	return u.TokenVersion, nil
+}